@@ -0,0 +1,115 @@
+package aio
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// ==================== Future 适配 ====================
+
+// ReadAllAsync 在后台读取 r 的全部内容
+func ReadAllAsync(r io.Reader) future.Future[[]byte] {
+	return future.NewE(func() ([]byte, error) {
+		return io.ReadAll(r)
+	})
+}
+
+// CopyAsync 在后台将 src 复制到 dst，结果为复制的字节数
+func CopyAsync(dst io.Writer, src io.Reader) future.Future[int64] {
+	return future.NewE(func() (int64, error) {
+		return io.Copy(dst, src)
+	})
+}
+
+// CopyAsyncCtx 与 CopyAsync 相同，但在 ctx 结束后停止复制
+// 取消只在两次读取之间生效，阻塞中的 Read 不会被打断
+func CopyAsyncCtx(ctx context.Context, dst io.Writer, src io.Reader) future.Future[int64] {
+	return future.NewWithContextE(ctx, func() (int64, error) {
+		return io.Copy(dst, &ctxReader{ctx: ctx, r: src})
+	})
+}
+
+// WriteAsync 在后台将 p 写入 w，结果为写入的字节数
+func WriteAsync(w io.Writer, p []byte) future.Future[int] {
+	return future.NewE(func() (int, error) {
+		return w.Write(p)
+	})
+}
+
+// ctxReader 每次读取前检查 ctx
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *ctxReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
+
+// ==================== 流式读取 ====================
+
+// Lines 逐行读取 r，每行（不含换行符）以 Ok 发送到返回的通道
+// 读取出错时发送一个 Err 后关闭通道；读到 EOF 或 ctx 结束时直接关闭。
+// 取消只在两次读取之间生效，阻塞中的 Read 不会被打断。
+func Lines(ctx context.Context, r io.Reader) <-chan option.Result[string, error] {
+	out := make(chan option.Result[string, error])
+	go func() {
+		defer close(out)
+		sc := bufio.NewScanner(&ctxReader{ctx: ctx, r: r})
+		sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for sc.Scan() {
+			if !send(ctx, out, option.Ok[string, error](sc.Text())) {
+				return
+			}
+		}
+		if err := sc.Err(); err != nil && ctx.Err() == nil {
+			send(ctx, out, option.Err[string](err))
+		}
+	}()
+	return out
+}
+
+// Chunks 以最多 size 字节的块读取 r，语义与 Lines 相同
+// 每个块都是新分配的切片，接收方可以安全持有
+func Chunks(ctx context.Context, r io.Reader, size int) <-chan option.Result[[]byte, error] {
+	if size <= 0 {
+		panic("aio: non-positive chunk size")
+	}
+	out := make(chan option.Result[[]byte, error])
+	go func() {
+		defer close(out)
+		cr := &ctxReader{ctx: ctx, r: r}
+		for {
+			buf := make([]byte, size)
+			n, err := cr.Read(buf)
+			if n > 0 && !send(ctx, out, option.Ok[[]byte, error](buf[:n])) {
+				return
+			}
+			if err == io.EOF || ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				send(ctx, out, option.Err[[]byte](err))
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// send 发送到 out，ctx 结束时返回 false
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
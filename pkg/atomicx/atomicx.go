@@ -0,0 +1,191 @@
+package atomicx
+
+import (
+	"math"
+	"math/bits"
+	"sync/atomic"
+)
+
+// ==================== Counter ====================
+
+// Counter 原子计数器，零值可用
+type Counter struct {
+	v    atomic.Int64
+	last atomic.Int64
+}
+
+// CounterSnapshot 计数器快照
+type CounterSnapshot struct {
+	// Value 快照时的计数
+	Value int64
+	// Delta 与上一次快照相比的增量
+	Delta int64
+}
+
+// Add 增加 delta 并返回新值
+func (c *Counter) Add(delta int64) int64 { return c.v.Add(delta) }
+
+// Inc 加一并返回新值
+func (c *Counter) Inc() int64 { return c.v.Add(1) }
+
+// Dec 减一并返回新值
+func (c *Counter) Dec() int64 { return c.v.Add(-1) }
+
+// Load 返回当前值
+func (c *Counter) Load() int64 { return c.v.Load() }
+
+// Reset 归零并返回归零前的值
+func (c *Counter) Reset() int64 {
+	c.last.Store(0)
+	return c.v.Swap(0)
+}
+
+// Snapshot 返回当前值以及自上次快照以来的增量
+func (c *Counter) Snapshot() CounterSnapshot {
+	cur := c.v.Load()
+	prev := c.last.Swap(cur)
+	return CounterSnapshot{Value: cur, Delta: cur - prev}
+}
+
+// ==================== Flag ====================
+
+// Flag 原子布尔标志，零值为未设置
+type Flag struct {
+	v atomic.Bool
+}
+
+// Set 设置标志
+func (f *Flag) Set() { f.v.Store(true) }
+
+// Clear 清除标志
+func (f *Flag) Clear() { f.v.Store(false) }
+
+// IsSet 标志是否已设置
+func (f *Flag) IsSet() bool { return f.v.Load() }
+
+// TestAndSet 设置标志并返回设置前的状态
+func (f *Flag) TestAndSet() bool { return f.v.Swap(true) }
+
+// TestAndClear 清除标志并返回清除前的状态
+func (f *Flag) TestAndClear() bool { return f.v.Swap(false) }
+
+// ==================== Bitset ====================
+
+// Bitset 固定长度的原子位图，每一位都可以被并发地独立读写
+type Bitset struct {
+	words []atomic.Uint64
+	n     int
+}
+
+// NewBitset 创建 n 位的位图
+func NewBitset(n int) *Bitset {
+	if n < 0 {
+		panic("atomicx: negative bitset size")
+	}
+	return &Bitset{words: make([]atomic.Uint64, (n+63)/64), n: n}
+}
+
+// Len 返回位数
+func (b *Bitset) Len() int { return b.n }
+
+func (b *Bitset) locate(i int) (*atomic.Uint64, uint64) {
+	if i < 0 || i >= b.n {
+		panic("atomicx: bitset index out of range")
+	}
+	return &b.words[i/64], 1 << uint(i%64)
+}
+
+// Test 第 i 位是否为 1
+func (b *Bitset) Test(i int) bool {
+	w, mask := b.locate(i)
+	return w.Load()&mask != 0
+}
+
+// Set 将第 i 位置 1
+func (b *Bitset) Set(i int) {
+	w, mask := b.locate(i)
+	w.Or(mask)
+}
+
+// Clear 将第 i 位置 0
+func (b *Bitset) Clear(i int) {
+	w, mask := b.locate(i)
+	w.And(^mask)
+}
+
+// TestAndSet 将第 i 位置 1 并返回之前的值
+func (b *Bitset) TestAndSet(i int) bool {
+	w, mask := b.locate(i)
+	return w.Or(mask)&mask != 0
+}
+
+// TestAndClear 将第 i 位置 0 并返回之前的值
+func (b *Bitset) TestAndClear(i int) bool {
+	w, mask := b.locate(i)
+	return w.And(^mask)&mask != 0
+}
+
+// Count 返回为 1 的位数（非原子快照）
+func (b *Bitset) Count() int {
+	n := 0
+	for i := range b.words {
+		n += bits.OnesCount64(b.words[i].Load())
+	}
+	return n
+}
+
+// ClearAll 清除所有位（非原子快照）
+func (b *Bitset) ClearAll() {
+	for i := range b.words {
+		b.words[i].Store(0)
+	}
+}
+
+// ==================== Float64 ====================
+
+// Float64 原子 float64，零值为 0
+type Float64 struct {
+	bits atomic.Uint64
+}
+
+// Load 返回当前值
+func (f *Float64) Load() float64 { return math.Float64frombits(f.bits.Load()) }
+
+// Store 设置值
+func (f *Float64) Store(v float64) { f.bits.Store(math.Float64bits(v)) }
+
+// Swap 设置新值并返回旧值
+func (f *Float64) Swap(v float64) float64 {
+	return math.Float64frombits(f.bits.Swap(math.Float64bits(v)))
+}
+
+// CompareAndSwap 当前值按位等于 old 时设置为 new
+func (f *Float64) CompareAndSwap(old, new float64) bool {
+	return f.bits.CompareAndSwap(math.Float64bits(old), math.Float64bits(new))
+}
+
+// Add 累加 delta 并返回新值
+func (f *Float64) Add(delta float64) float64 {
+	return f.update(func(v float64) float64 { return v + delta })
+}
+
+// StoreMax 当 v 更大时更新，返回更新后的值
+func (f *Float64) StoreMax(v float64) float64 {
+	return f.update(func(cur float64) float64 { return max(cur, v) })
+}
+
+// StoreMin 当 v 更小时更新，返回更新后的值
+func (f *Float64) StoreMin(v float64) float64 {
+	return f.update(func(cur float64) float64 { return min(cur, v) })
+}
+
+// update 通过 CAS 循环应用 fn
+func (f *Float64) update(fn func(float64) float64) float64 {
+	for {
+		old := f.bits.Load()
+		next := fn(math.Float64frombits(old))
+		if f.bits.CompareAndSwap(old, math.Float64bits(next)) {
+			return next
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package cmpx
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// ==================== 区间 ====================
+
+// Clamp 将 v 限制在 [lo, hi] 之间，lo > hi 时 panic
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	if cmp.Less(hi, lo) {
+		panic("cmpx: Clamp called with lo > hi")
+	}
+	if cmp.Less(v, lo) {
+		return lo
+	}
+	if cmp.Less(hi, v) {
+		return hi
+	}
+	return v
+}
+
+// Between v 是否在闭区间 [lo, hi] 内
+func Between[T cmp.Ordered](v, lo, hi T) bool {
+	return !cmp.Less(v, lo) && !cmp.Less(hi, v)
+}
+
+// ==================== 最值 ====================
+
+// Min 返回最小值，没有参数时返回 None
+func Min[T cmp.Ordered](vs ...T) option.Option[T] {
+	if len(vs) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(slices.Min(vs))
+}
+
+// Max 返回最大值，没有参数时返回 None
+func Max[T cmp.Ordered](vs ...T) option.Option[T] {
+	if len(vs) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(slices.Max(vs))
+}
+
+// MinBy 按比较函数返回最小值，相等时取第一个
+func MinBy[T any](c func(a, b T) int, vs ...T) option.Option[T] {
+	if len(vs) == 0 {
+		return option.None[T]()
+	}
+	best := vs[0]
+	for _, v := range vs[1:] {
+		if c(v, best) < 0 {
+			best = v
+		}
+	}
+	return option.Some(best)
+}
+
+// MaxBy 按比较函数返回最大值，相等时取第一个
+func MaxBy[T any](c func(a, b T) int, vs ...T) option.Option[T] {
+	if len(vs) == 0 {
+		return option.None[T]()
+	}
+	best := vs[0]
+	for _, v := range vs[1:] {
+		if c(v, best) > 0 {
+			best = v
+		}
+	}
+	return option.Some(best)
+}
+
+// ==================== 比较器 ====================
+
+// Comparator 比较函数，a < b 返回负数，相等返回 0，a > b 返回正数
+// 可直接用于 slices.SortFunc
+type Comparator[T any] func(a, b T) int
+
+// CompareBy 按 key 的自然顺序比较
+func CompareBy[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Then 当前比较结果相等时使用 next 继续比较
+func (c Comparator[T]) Then(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+// Reverse 反转比较顺序
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// ThenBy 当前比较结果相等时按 key 继续比较
+func ThenBy[T any, K cmp.Ordered](c Comparator[T], key func(T) K) Comparator[T] {
+	return c.Then(CompareBy(key))
+}
+
+// Chain 依次使用多个比较器，直到结果不为 0
+func Chain[T any](cs ...Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		for _, c := range cs {
+			if r := c(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
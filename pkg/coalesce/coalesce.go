@@ -0,0 +1,96 @@
+package coalesce
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+)
+
+// pending 一次尚未执行的合并调用
+type pending[A, T any] struct {
+	timer *time.Timer
+	fire  chan struct{}
+	arg   A
+	fut   future.Future[T]
+}
+
+// ==================== 防抖 ====================
+
+// Debounced 返回防抖后的触发函数
+// 每次触发都会把执行推迟到最后一次触发后 wait 时长，
+// 同一批连续触发的调用方拿到同一个 Future，fn 只执行一次
+func Debounced[T any](fn func() (T, error), wait time.Duration) func() future.Future[T] {
+	var (
+		mu  sync.Mutex
+		cur *pending[struct{}, T]
+	)
+
+	return func() future.Future[T] {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// Stop 成功说明尚未触发，可以安全地推迟
+		if cur != nil && cur.timer.Stop() {
+			cur.timer.Reset(wait)
+			return cur.fut
+		}
+
+		p := &pending[struct{}, T]{fire: make(chan struct{})}
+		p.timer = time.AfterFunc(wait, func() {
+			mu.Lock()
+			if cur == p {
+				cur = nil
+			}
+			mu.Unlock()
+			close(p.fire)
+		})
+		p.fut = future.NewE(func() (T, error) {
+			<-p.fire
+			return fn()
+		})
+		cur = p
+		return p.fut
+	}
+}
+
+// ==================== 按键合并 ====================
+
+// Coalesce 返回按键合并的触发函数
+// 同一个键在首次触发后的 window 时长内的所有触发合并为一次执行，
+// 执行时使用窗口内最后一次触发的参数，所有调用方拿到同一个 Future
+func Coalesce[K comparable, T any, A any](keyFn func(A) K, window time.Duration, fn func(A) (T, error)) func(A) future.Future[T] {
+	var (
+		mu      sync.Mutex
+		batches = make(map[K]*pending[A, T])
+	)
+
+	return func(arg A) future.Future[T] {
+		key := keyFn(arg)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if p, ok := batches[key]; ok {
+			p.arg = arg
+			return p.fut
+		}
+
+		p := &pending[A, T]{fire: make(chan struct{}), arg: arg}
+		p.timer = time.AfterFunc(window, func() {
+			mu.Lock()
+			if batches[key] == p {
+				delete(batches, key)
+			}
+			mu.Unlock()
+			close(p.fire)
+		})
+		p.fut = future.NewE(func() (T, error) {
+			<-p.fire
+			// 定时器触发后该批次已从表中移除，arg 不会再被修改
+			return fn(p.arg)
+		})
+		batches[key] = p
+		return p.fut
+	}
+}
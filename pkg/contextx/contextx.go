@@ -0,0 +1,163 @@
+package contextx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// ==================== Merge ====================
+
+// mergedCtx 合并两个 Context：任一取消即取消，值优先从第一个查找
+type mergedCtx struct {
+	context.Context
+	first  context.Context
+	second context.Context
+}
+
+// Merge 返回在 ctx1 或 ctx2 任一取消时取消的 Context
+// 值先在 ctx1 中查找，再在 ctx2 中查找；截止时间取两者中较早的一个。
+// 调用方应在不再需要时调用返回的 CancelFunc 以释放资源。
+func Merge(ctx1, ctx2 context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx1)
+	stop := context.AfterFunc(ctx2, func() {
+		cancel(context.Cause(ctx2))
+	})
+	m := &mergedCtx{Context: ctx, first: ctx1, second: ctx2}
+	return m, func() {
+		stop()
+		cancel(context.Canceled)
+	}
+}
+
+func (m *mergedCtx) Deadline() (time.Time, bool) {
+	d1, ok1 := m.first.Deadline()
+	d2, ok2 := m.second.Deadline()
+	switch {
+	case ok1 && ok2:
+		if d2.Before(d1) {
+			return d2, true
+		}
+		return d1, true
+	case ok2:
+		return d2, true
+	default:
+		return d1, ok1
+	}
+}
+
+// Err 优先报告源 Context 的错误，以保留 DeadlineExceeded
+func (m *mergedCtx) Err() error {
+	if err := m.Context.Err(); err == nil {
+		return nil
+	}
+	if err := m.first.Err(); err != nil {
+		return err
+	}
+	if err := m.second.Err(); err != nil {
+		return err
+	}
+	return m.Context.Err()
+}
+
+// Value 经由内部的 cancelCtx 查找 ctx1，使 context.Cause 能找到合并后的取消原因
+func (m *mergedCtx) Value(key any) any {
+	if v := m.Context.Value(key); v != nil {
+		return v
+	}
+	return m.second.Value(key)
+}
+
+func (m *mergedCtx) String() string {
+	return fmt.Sprintf("contextx.Merge(%v, %v)", m.first, m.second)
+}
+
+// ==================== Detach ====================
+
+// Detach 返回保留 ctx 的值但不随其取消、没有截止时间的 Context
+// 适用于请求结束后仍需继续执行的后台任务
+func Detach(ctx context.Context) context.Context {
+	return context.WithoutCancel(ctx)
+}
+
+// ==================== 类型化的键 ====================
+
+// Key 类型化的 Context 键，每个 Key 实例都是唯一的
+type Key[T any] struct {
+	name string
+}
+
+// NewKey 创建类型化的键，name 仅用于调试输出
+func NewKey[T any](name string) *Key[T] {
+	return &Key[T]{name: name}
+}
+
+func (k *Key[T]) String() string { return "contextx.Key(" + k.name + ")" }
+
+// With 返回携带该键值的 Context
+func (k *Key[T]) With(ctx context.Context, v T) context.Context {
+	return context.WithValue(ctx, k, v)
+}
+
+// Get 读取键值，不存在时返回 None
+func (k *Key[T]) Get(ctx context.Context) option.Option[T] {
+	if v, ok := ctx.Value(k).(T); ok {
+		return option.Some(v)
+	}
+	return option.None[T]()
+}
+
+// MustGet 读取键值，不存在时 panic
+func (k *Key[T]) MustGet(ctx context.Context) T {
+	return k.Get(ctx).Expect(k.String() + " not found in context")
+}
+
+// Bind 将键与值绑定，用于 WithValues 批量附加
+func (k *Key[T]) Bind(v T) Binding {
+	return Binding{key: k, value: v}
+}
+
+// ==================== 批量附加 ====================
+
+// Binding 一个键值绑定
+type Binding struct {
+	key   any
+	value any
+}
+
+// Pair 用任意可比较的键创建绑定
+func Pair(key, value any) Binding {
+	return Binding{key: key, value: value}
+}
+
+// valuesCtx 一次附加多个值的 Context，避免多层 WithValue 嵌套
+type valuesCtx struct {
+	context.Context
+	values map[any]any
+}
+
+// WithValues 一次附加多个键值，查找时只增加一层 Context
+// 同一个键出现多次时以最后一次为准
+func WithValues(ctx context.Context, bindings ...Binding) context.Context {
+	if len(bindings) == 0 {
+		return ctx
+	}
+	values := make(map[any]any, len(bindings))
+	for _, b := range bindings {
+		values[b.key] = b.value
+	}
+	return &valuesCtx{Context: ctx, values: values}
+}
+
+func (c *valuesCtx) Value(key any) any {
+	if v, ok := c.values[key]; ok {
+		return v
+	}
+	return c.Context.Value(key)
+}
+
+func (c *valuesCtx) String() string {
+	return fmt.Sprintf("%v.WithValues(%d)", c.Context, len(c.values))
+}
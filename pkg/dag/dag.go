@@ -0,0 +1,269 @@
+package dag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+)
+
+var (
+	// ErrStarted 图已经启动，不能再添加节点或重复启动
+	ErrStarted = errors.New("dag: graph already started")
+	// ErrNotStarted 图尚未启动
+	ErrNotStarted = errors.New("dag: graph not started")
+)
+
+// UpstreamError 依赖节点失败导致当前节点未执行
+type UpstreamError struct {
+	Node     string
+	Upstream string
+	Err      error
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("dag: node %q skipped: upstream %q failed: %v", e.Node, e.Upstream, e.Err)
+}
+
+func (e *UpstreamError) Unwrap() error { return e.Err }
+
+// ==================== 重试策略 ====================
+
+// RetryPolicy 节点重试策略，零值表示不重试
+type RetryPolicy struct {
+	// MaxAttempts 最大尝试次数（包含第一次），<=1 表示不重试
+	MaxAttempts int
+	// Backoff 第一次重试前的等待时间
+	Backoff time.Duration
+	// Multiplier 每次重试后等待时间的倍数，<=1 表示固定间隔
+	Multiplier float64
+	// RetryIf 判断错误是否值得重试，nil 表示总是重试
+	RetryIf func(error) bool
+}
+
+// NodeOption 节点配置选项
+type NodeOption func(*node)
+
+// DependsOn 声明节点依赖
+func DependsOn(deps ...Dep) NodeOption {
+	return func(n *node) {
+		for _, d := range deps {
+			n.deps = append(n.deps, d.dagNode())
+		}
+	}
+}
+
+// WithRetry 设置节点重试策略
+func WithRetry(p RetryPolicy) NodeOption {
+	return func(n *node) { n.retry = p }
+}
+
+// ==================== 图与节点 ====================
+
+// Dep 可以作为依赖的节点
+type Dep interface {
+	dagNode() *node
+}
+
+// node 图中节点的非类型化部分
+type node struct {
+	g     *Graph
+	name  string
+	deps  []*node
+	retry RetryPolicy
+
+	// start 启动节点对应的 Future
+	start func(ctx context.Context)
+	// fut 节点的 Future，用于等待完成和读取错误
+	fut interface {
+		Wait(timeout ...time.Duration) bool
+		Error() error
+	}
+}
+
+// Graph 有向无环任务图
+// 节点只能依赖已添加的节点，因此图在构造上不会出现环
+type Graph struct {
+	mu      sync.Mutex
+	nodes   []*node
+	names   map[string]*node
+	started bool
+	exec    *future.Executor
+}
+
+// Option 图配置选项
+type Option func(*Graph)
+
+// WithExecutor 让节点在共享的执行器上运行，而不是各自占用一个 goroutine
+// 节点按添加顺序入队，依赖总是先于下游开始运行
+func WithExecutor(e *future.Executor) Option {
+	return func(g *Graph) { g.exec = e }
+}
+
+// New 创建空的任务图
+func New(opts ...Option) *Graph {
+	g := &Graph{names: make(map[string]*node)}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Node 类型化的节点句柄
+type Node[T any] struct {
+	n   *node
+	fut future.Future[T]
+}
+
+func (n *Node[T]) dagNode() *node { return n.n }
+
+// Name 返回节点名称
+func (n *Node[T]) Name() string { return n.n.name }
+
+// Future 返回节点输出的 Future，图启动前返回 nil
+func (n *Node[T]) Future() future.Future[T] {
+	n.n.g.mu.Lock()
+	defer n.n.g.mu.Unlock()
+	return n.fut
+}
+
+// Value 等待节点完成并返回其输出，通常在下游节点中读取依赖结果
+func (n *Node[T]) Value() T {
+	return n.Future().Get()
+}
+
+// Add 向图中添加节点，依赖通过 DependsOn 声明
+// 名称重复、依赖不属于本图或图已启动时 panic
+func Add[T any](g *Graph, name string, fn func(ctx context.Context) (T, error), opts ...NodeOption) *Node[T] {
+	n := &node{g: g, name: name}
+	for _, opt := range opts {
+		opt(n)
+	}
+	typed := &Node[T]{n: n}
+
+	n.start = func(ctx context.Context) {
+		// 节点体使用 Future 自身的 ctx，Future().Cancel() 会中断 fn 及其重试等待
+		body := func(ctx context.Context) (T, error) {
+			for _, d := range n.deps {
+				if err := d.fut.Error(); err != nil {
+					var zero T
+					return zero, &UpstreamError{Node: n.name, Upstream: d.name, Err: err}
+				}
+			}
+			return runWithRetry(ctx, n.retry, fn)
+		}
+		var f future.Future[T]
+		if g.exec != nil {
+			f = future.NewOnCtx(g.exec, ctx, body)
+		} else {
+			f = future.NewCtx(ctx, body)
+		}
+		typed.fut = f
+		n.fut = f
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.started {
+		panic(ErrStarted)
+	}
+	if _, ok := g.names[name]; ok {
+		panic(fmt.Sprintf("dag: duplicate node %q", name))
+	}
+	for _, d := range n.deps {
+		if d.g != g {
+			panic(fmt.Sprintf("dag: node %q depends on %q from another graph", name, d.name))
+		}
+	}
+	g.names[name] = n
+	g.nodes = append(g.nodes, n)
+	return typed
+}
+
+// Add1 添加依赖单个节点的节点，依赖的输出作为参数传入
+func Add1[A, T any](g *Graph, name string, a *Node[A], fn func(ctx context.Context, a A) (T, error), opts ...NodeOption) *Node[T] {
+	opts = append([]NodeOption{DependsOn(a)}, opts...)
+	return Add(g, name, func(ctx context.Context) (T, error) {
+		return fn(ctx, a.Value())
+	}, opts...)
+}
+
+// Add2 添加依赖两个节点的节点
+func Add2[A, B, T any](g *Graph, name string, a *Node[A], b *Node[B], fn func(ctx context.Context, a A, b B) (T, error), opts ...NodeOption) *Node[T] {
+	opts = append([]NodeOption{DependsOn(a, b)}, opts...)
+	return Add(g, name, func(ctx context.Context) (T, error) {
+		return fn(ctx, a.Value(), b.Value())
+	}, opts...)
+}
+
+// Start 启动图中所有节点，相互独立的节点并发执行
+// 节点失败会沿依赖边传播为 UpstreamError；ctx 取消会传播到所有尚未完成的节点
+func (g *Graph) Start(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.started {
+		return ErrStarted
+	}
+	g.started = true
+	// 节点按添加顺序启动，依赖一定先于下游启动
+	for _, n := range g.nodes {
+		n.start(ctx)
+	}
+	return nil
+}
+
+// Wait 等待所有节点完成，返回根因错误的合并结果
+// 因上游失败而跳过的节点不重复计入
+func (g *Graph) Wait() error {
+	g.mu.Lock()
+	if !g.started {
+		g.mu.Unlock()
+		return ErrNotStarted
+	}
+	nodes := g.nodes
+	g.mu.Unlock()
+
+	var errs []error
+	for _, n := range nodes {
+		err := n.fut.Error()
+		var up *UpstreamError
+		if err != nil && !errors.As(err, &up) {
+			errs = append(errs, fmt.Errorf("dag: node %q: %w", n.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run 启动并等待整个图完成
+func (g *Graph) Run(ctx context.Context) error {
+	if err := g.Start(ctx); err != nil {
+		return err
+	}
+	return g.Wait()
+}
+
+// runWithRetry 按策略执行节点函数
+func runWithRetry[T any](ctx context.Context, p RetryPolicy, fn func(ctx context.Context) (T, error)) (T, error) {
+	backoff := p.Backoff
+	for attempt := 1; ; attempt++ {
+		v, err := fn(ctx)
+		if err == nil || attempt >= p.MaxAttempts || (p.RetryIf != nil && !p.RetryIf(err)) {
+			return v, err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			var zero T
+			return zero, ctx.Err()
+		case <-timer.C:
+		}
+		if p.Multiplier > 1 {
+			backoff = time.Duration(float64(backoff) * p.Multiplier)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package defaults
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ==================== 零值 ====================
+
+// Zero 返回 T 的零值
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+// IsZero v 是否为零值
+func IsZero[T comparable](v T) bool {
+	var zero T
+	return v == zero
+}
+
+// ==================== 默认值 ====================
+
+// Defaulter 能够提供自身默认值的类型，通常在值接收者上实现：
+//
+//	func (Config) Default() Config { return Config{Port: 8080} }
+type Defaulter[T any] interface {
+	Default() T
+}
+
+var (
+	providersMu sync.RWMutex
+	providers   = make(map[reflect.Type]any)
+)
+
+// Register 为类型 T 注册默认值提供函数，优先于 Defaulter 实现
+func Register[T any](fn func() T) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	providers[reflect.TypeFor[T]()] = fn
+}
+
+// Value 返回 T 的默认值
+// 依次查找：Register 注册的提供函数、T 的 Defaulter 实现，都没有时返回零值
+func Value[T any]() T {
+	providersMu.RLock()
+	fn, ok := providers[reflect.TypeFor[T]()]
+	providersMu.RUnlock()
+	if ok {
+		return fn.(func() T)()
+	}
+
+	var zero T
+	if d, ok := any(zero).(Defaulter[T]); ok {
+		return d.Default()
+	}
+	return zero
+}
+
+// Or 在 v 为零值时返回 T 的默认值
+func Or[T comparable](v T) T {
+	if IsZero(v) {
+		return Value[T]()
+	}
+	return v
+}
+
+// Unwrapper 提供 UnwrapOr 方法的类型，例如 option.Option 与 option.Result
+type Unwrapper[T any] interface {
+	UnwrapOr(defaultValue T) T
+}
+
+// Unwrap 解包 Option/Result，无值时使用 T 的默认值
+func Unwrap[T any](u Unwrapper[T]) T {
+	return u.UnwrapOr(Value[T]())
+}
+
+// ==================== 结构体标签 ====================
+
+// FillDefaults 用 `default` 标签填充 ptr 指向的结构体中的零值字段
+// 嵌套结构体会被递归填充；零值字段的类型实现了 Default() 方法时优先使用该方法。
+// 支持字符串、布尔、整数、浮点数、time.Duration 以及逗号分隔的切片。
+func FillDefaults(ptr any) error {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("defaults: FillDefaults expects a non-nil pointer to struct, got %T", ptr)
+	}
+	return fillStruct(v.Elem())
+}
+
+func fillStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+
+		if fv.IsZero() {
+			if d, ok := defaultMethod(fv); ok {
+				fv.Set(d)
+				continue
+			}
+			if tag, ok := sf.Tag.Lookup("default"); ok {
+				if err := setFromString(fv, tag); err != nil {
+					return fmt.Errorf("defaults: field %s: %w", sf.Name, err)
+				}
+				continue
+			}
+		}
+
+		if fv.Kind() == reflect.Struct {
+			if err := fillStruct(fv); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// defaultMethod 调用字段类型的 Default() 方法（返回值类型须与字段相同）
+func defaultMethod(fv reflect.Value) (reflect.Value, bool) {
+	m := fv.MethodByName("Default")
+	if !m.IsValid() {
+		return reflect.Value{}, false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 0 || mt.NumOut() != 1 || mt.Out(0) != fv.Type() {
+		return reflect.Value{}, false
+	}
+	return m.Call(nil)[0], true
+}
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+func setFromString(fv reflect.Value, s string) error {
+	if fv.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(int64(d))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 0, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 0, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(f)
+	case reflect.Slice:
+		parts := strings.Split(s, ",")
+		slice := reflect.MakeSlice(fv.Type(), len(parts), len(parts))
+		for i, p := range parts {
+			if err := setFromString(slice.Index(i), strings.TrimSpace(p)); err != nil {
+				return err
+			}
+		}
+		fv.Set(slice)
+	case reflect.Pointer:
+		elem := reflect.New(fv.Type().Elem())
+		if err := setFromString(elem.Elem(), s); err != nil {
+			return err
+		}
+		fv.Set(elem)
+	default:
+		return fmt.Errorf("unsupported kind %s", fv.Kind())
+	}
+	return nil
+}
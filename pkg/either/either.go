@@ -0,0 +1,160 @@
+package either
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// Either 表示两种可能形态之一的值：Left 或 Right
+// 与 Result 不同，两边都可以是正常结果
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left 创建 Left 值
+func Left[L, R any](v L) Either[L, R] {
+	return Either[L, R]{left: v}
+}
+
+// Right 创建 Right 值
+func Right[L, R any](v R) Either[L, R] {
+	return Either[L, R]{right: v, isRight: true}
+}
+
+// 基本操作
+func (e Either[L, R]) IsLeft() bool  { return !e.isRight }
+func (e Either[L, R]) IsRight() bool { return e.isRight }
+
+// Left 以 Option 形式返回 Left 值
+func (e Either[L, R]) Left() option.Option[L] {
+	if e.isRight {
+		return option.None[L]()
+	}
+	return option.Some(e.left)
+}
+
+// Right 以 Option 形式返回 Right 值
+func (e Either[L, R]) Right() option.Option[R] {
+	if e.isRight {
+		return option.Some(e.right)
+	}
+	return option.None[R]()
+}
+
+func (e Either[L, R]) UnwrapLeft() L {
+	if e.isRight {
+		panic("called `UnwrapLeft()` on a `Right` value")
+	}
+	return e.left
+}
+
+func (e Either[L, R]) UnwrapRight() R {
+	if !e.isRight {
+		panic("called `UnwrapRight()` on a `Left` value")
+	}
+	return e.right
+}
+
+// Swap 交换两侧
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+func (e Either[L, R]) String() string {
+	if e.isRight {
+		return fmt.Sprintf("Right(%v)", e.right)
+	}
+	return fmt.Sprintf("Left(%v)", e.left)
+}
+
+// 独立函数版本的各种操作
+
+// MapLeft 转换 Left 值，Right 保持不变
+func MapLeft[L, R, M any](e Either[L, R], f func(L) M) Either[M, R] {
+	if e.isRight {
+		return Right[M](e.right)
+	}
+	return Left[M, R](f(e.left))
+}
+
+// MapRight 转换 Right 值，Left 保持不变
+func MapRight[L, R, M any](e Either[L, R], f func(R) M) Either[L, M] {
+	if e.isRight {
+		return Right[L](f(e.right))
+	}
+	return Left[L, M](e.left)
+}
+
+// Fold 根据所在一侧调用对应函数，将两种形态归并为同一类型
+func Fold[L, R, U any](e Either[L, R], onLeft func(L) U, onRight func(R) U) U {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// ToResult 转换为 Result，Right 对应 Ok，Left 对应 Err
+func ToResult[L, R any](e Either[L, R]) option.Result[R, L] {
+	if e.isRight {
+		return option.Ok[R, L](e.right)
+	}
+	return option.Err[R](e.left)
+}
+
+// FromResult 从 Result 创建，Ok 对应 Right，Err 对应 Left
+func FromResult[T, E any](r option.Result[T, E]) Either[E, T] {
+	return option.MatchResult(r,
+		func(v T) Either[E, T] { return Right[E](v) },
+		func(err E) Either[E, T] { return Left[E, T](err) },
+	)
+}
+
+// ============================================================================
+// JSON 编码
+// ============================================================================
+
+// eitherJSON JSON 表示：{"left": ...} 或 {"right": ...}
+type eitherJSON struct {
+	Left  json.RawMessage `json:"left,omitempty"`
+	Right json.RawMessage `json:"right,omitempty"`
+}
+
+// MarshalJSON 编码为 {"left": v} 或 {"right": v}
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	if e.isRight {
+		return json.Marshal(map[string]any{"right": e.right})
+	}
+	return json.Marshal(map[string]any{"left": e.left})
+}
+
+// UnmarshalJSON 解码 {"left": v} 或 {"right": v}，必须且只能包含其一
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	var raw eitherJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch {
+	case raw.Left != nil && raw.Right != nil:
+		return errors.New("either: JSON object has both \"left\" and \"right\"")
+	case raw.Right != nil:
+		var v R
+		if err := json.Unmarshal(raw.Right, &v); err != nil {
+			return err
+		}
+		*e = Right[L](v)
+	case raw.Left != nil:
+		var v L
+		if err := json.Unmarshal(raw.Left, &v); err != nil {
+			return err
+		}
+		*e = Left[L, R](v)
+	default:
+		return errors.New("either: JSON object needs \"left\" or \"right\"")
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+// Package enum 提供封闭的联合类型 UnionN，每个值恰好持有 N 个变体之一。
+// 访问变体只能通过 Visit/VisitN，其参数列表与变体一一对应：
+// 给联合类型增加变体会改变类型本身，所有访问点都会编译失败，
+// 从而不会像 type switch 一样静默地落入 default 分支。
+package enum
+
+import "fmt"
+
+// ==================== Union2 ====================
+
+// Union2 2 个变体的封闭联合类型，零值持有 A 的零值
+type Union2[A, B any] struct {
+	tag uint8
+	a   A
+	b   B
+}
+
+// A2 创建持有第 1 个变体的 Union2
+func A2[A, B any](v A) Union2[A, B] {
+	return Union2[A, B]{tag: 0, a: v}
+}
+
+// B2 创建持有第 2 个变体的 Union2
+func B2[A, B any](v B) Union2[A, B] {
+	return Union2[A, B]{tag: 1, b: v}
+}
+
+// Index 返回所持变体的序号（从 0 开始）
+func (u Union2[A, B]) Index() int { return int(u.tag) }
+
+// Visit 调用与所持变体对应的函数
+func (u Union2[A, B]) Visit(onA func(A), onB func(B)) {
+	switch u.tag {
+	case 0:
+		onA(u.a)
+	default:
+		onB(u.b)
+	}
+}
+
+func (u Union2[A, B]) String() string {
+	switch u.tag {
+	case 0:
+		return fmt.Sprintf("A(%v)", u.a)
+	default:
+		return fmt.Sprintf("B(%v)", u.b)
+	}
+}
+
+// Visit2 调用与所持变体对应的函数并返回其结果
+func Visit2[A, B, R any](u Union2[A, B], onA func(A) R, onB func(B) R) R {
+	switch u.tag {
+	case 0:
+		return onA(u.a)
+	default:
+		return onB(u.b)
+	}
+}
+
+// ==================== Union3 ====================
+
+// Union3 3 个变体的封闭联合类型，零值持有 A 的零值
+type Union3[A, B, C any] struct {
+	tag uint8
+	a   A
+	b   B
+	c   C
+}
+
+// A3 创建持有第 1 个变体的 Union3
+func A3[A, B, C any](v A) Union3[A, B, C] {
+	return Union3[A, B, C]{tag: 0, a: v}
+}
+
+// B3 创建持有第 2 个变体的 Union3
+func B3[A, B, C any](v B) Union3[A, B, C] {
+	return Union3[A, B, C]{tag: 1, b: v}
+}
+
+// C3 创建持有第 3 个变体的 Union3
+func C3[A, B, C any](v C) Union3[A, B, C] {
+	return Union3[A, B, C]{tag: 2, c: v}
+}
+
+// Index 返回所持变体的序号（从 0 开始）
+func (u Union3[A, B, C]) Index() int { return int(u.tag) }
+
+// Visit 调用与所持变体对应的函数
+func (u Union3[A, B, C]) Visit(onA func(A), onB func(B), onC func(C)) {
+	switch u.tag {
+	case 0:
+		onA(u.a)
+	case 1:
+		onB(u.b)
+	default:
+		onC(u.c)
+	}
+}
+
+func (u Union3[A, B, C]) String() string {
+	switch u.tag {
+	case 0:
+		return fmt.Sprintf("A(%v)", u.a)
+	case 1:
+		return fmt.Sprintf("B(%v)", u.b)
+	default:
+		return fmt.Sprintf("C(%v)", u.c)
+	}
+}
+
+// Visit3 调用与所持变体对应的函数并返回其结果
+func Visit3[A, B, C, R any](u Union3[A, B, C], onA func(A) R, onB func(B) R, onC func(C) R) R {
+	switch u.tag {
+	case 0:
+		return onA(u.a)
+	case 1:
+		return onB(u.b)
+	default:
+		return onC(u.c)
+	}
+}
+
+// ==================== Union4 ====================
+
+// Union4 4 个变体的封闭联合类型，零值持有 A 的零值
+type Union4[A, B, C, D any] struct {
+	tag uint8
+	a   A
+	b   B
+	c   C
+	d   D
+}
+
+// A4 创建持有第 1 个变体的 Union4
+func A4[A, B, C, D any](v A) Union4[A, B, C, D] {
+	return Union4[A, B, C, D]{tag: 0, a: v}
+}
+
+// B4 创建持有第 2 个变体的 Union4
+func B4[A, B, C, D any](v B) Union4[A, B, C, D] {
+	return Union4[A, B, C, D]{tag: 1, b: v}
+}
+
+// C4 创建持有第 3 个变体的 Union4
+func C4[A, B, C, D any](v C) Union4[A, B, C, D] {
+	return Union4[A, B, C, D]{tag: 2, c: v}
+}
+
+// D4 创建持有第 4 个变体的 Union4
+func D4[A, B, C, D any](v D) Union4[A, B, C, D] {
+	return Union4[A, B, C, D]{tag: 3, d: v}
+}
+
+// Index 返回所持变体的序号（从 0 开始）
+func (u Union4[A, B, C, D]) Index() int { return int(u.tag) }
+
+// Visit 调用与所持变体对应的函数
+func (u Union4[A, B, C, D]) Visit(onA func(A), onB func(B), onC func(C), onD func(D)) {
+	switch u.tag {
+	case 0:
+		onA(u.a)
+	case 1:
+		onB(u.b)
+	case 2:
+		onC(u.c)
+	default:
+		onD(u.d)
+	}
+}
+
+func (u Union4[A, B, C, D]) String() string {
+	switch u.tag {
+	case 0:
+		return fmt.Sprintf("A(%v)", u.a)
+	case 1:
+		return fmt.Sprintf("B(%v)", u.b)
+	case 2:
+		return fmt.Sprintf("C(%v)", u.c)
+	default:
+		return fmt.Sprintf("D(%v)", u.d)
+	}
+}
+
+// Visit4 调用与所持变体对应的函数并返回其结果
+func Visit4[A, B, C, D, R any](u Union4[A, B, C, D], onA func(A) R, onB func(B) R, onC func(C) R, onD func(D) R) R {
+	switch u.tag {
+	case 0:
+		return onA(u.a)
+	case 1:
+		return onB(u.b)
+	case 2:
+		return onC(u.c)
+	default:
+		return onD(u.d)
+	}
+}
+
+// ==================== Union5 ====================
+
+// Union5 5 个变体的封闭联合类型，零值持有 A 的零值
+type Union5[A, B, C, D, E any] struct {
+	tag uint8
+	a   A
+	b   B
+	c   C
+	d   D
+	e   E
+}
+
+// A5 创建持有第 1 个变体的 Union5
+func A5[A, B, C, D, E any](v A) Union5[A, B, C, D, E] {
+	return Union5[A, B, C, D, E]{tag: 0, a: v}
+}
+
+// B5 创建持有第 2 个变体的 Union5
+func B5[A, B, C, D, E any](v B) Union5[A, B, C, D, E] {
+	return Union5[A, B, C, D, E]{tag: 1, b: v}
+}
+
+// C5 创建持有第 3 个变体的 Union5
+func C5[A, B, C, D, E any](v C) Union5[A, B, C, D, E] {
+	return Union5[A, B, C, D, E]{tag: 2, c: v}
+}
+
+// D5 创建持有第 4 个变体的 Union5
+func D5[A, B, C, D, E any](v D) Union5[A, B, C, D, E] {
+	return Union5[A, B, C, D, E]{tag: 3, d: v}
+}
+
+// E5 创建持有第 5 个变体的 Union5
+func E5[A, B, C, D, E any](v E) Union5[A, B, C, D, E] {
+	return Union5[A, B, C, D, E]{tag: 4, e: v}
+}
+
+// Index 返回所持变体的序号（从 0 开始）
+func (u Union5[A, B, C, D, E]) Index() int { return int(u.tag) }
+
+// Visit 调用与所持变体对应的函数
+func (u Union5[A, B, C, D, E]) Visit(onA func(A), onB func(B), onC func(C), onD func(D), onE func(E)) {
+	switch u.tag {
+	case 0:
+		onA(u.a)
+	case 1:
+		onB(u.b)
+	case 2:
+		onC(u.c)
+	case 3:
+		onD(u.d)
+	default:
+		onE(u.e)
+	}
+}
+
+func (u Union5[A, B, C, D, E]) String() string {
+	switch u.tag {
+	case 0:
+		return fmt.Sprintf("A(%v)", u.a)
+	case 1:
+		return fmt.Sprintf("B(%v)", u.b)
+	case 2:
+		return fmt.Sprintf("C(%v)", u.c)
+	case 3:
+		return fmt.Sprintf("D(%v)", u.d)
+	default:
+		return fmt.Sprintf("E(%v)", u.e)
+	}
+}
+
+// Visit5 调用与所持变体对应的函数并返回其结果
+func Visit5[A, B, C, D, E, R any](u Union5[A, B, C, D, E], onA func(A) R, onB func(B) R, onC func(C) R, onD func(D) R, onE func(E) R) R {
+	switch u.tag {
+	case 0:
+		return onA(u.a)
+	case 1:
+		return onB(u.b)
+	case 2:
+		return onC(u.c)
+	case 3:
+		return onD(u.d)
+	default:
+		return onE(u.e)
+	}
+}
@@ -0,0 +1,188 @@
+package errorsx
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+// ==================== Multi ====================
+
+// Multi 按添加顺序收集多个错误，并发安全，零值可用
+type Multi struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+// Add 添加错误，nil 会被忽略
+func (m *Multi) Add(errs ...error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, err := range errs {
+		if err != nil {
+			m.errs = append(m.errs, err)
+		}
+	}
+}
+
+// Addf 添加格式化的错误
+func (m *Multi) Addf(format string, args ...any) {
+	m.Add(fmt.Errorf(format, args...))
+}
+
+// Len 返回已收集的错误数
+func (m *Multi) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.errs)
+}
+
+// Errors 返回已收集错误的副本
+func (m *Multi) Errors() []error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]error(nil), m.errs...)
+}
+
+// Err 没有错误时返回 nil，只有一个时原样返回，否则返回 *MultiError
+func (m *Multi) Err() error {
+	errs := m.Errors()
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return &MultiError{errs: errs}
+	}
+}
+
+// MultiError 有序的多个错误，支持 errors.Is/As 遍历每一个错误
+type MultiError struct {
+	errs []error
+}
+
+func (e *MultiError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d errors occurred:", len(e.errs))
+	for i, err := range e.errs {
+		fmt.Fprintf(&b, "\n\t%d. %v", i+1, err)
+	}
+	return b.String()
+}
+
+func (e *MultiError) Unwrap() []error { return e.errs }
+
+// ==================== 标签 ====================
+
+// Kind 错误分类标签
+type Kind string
+
+// tagged 带标签的错误
+type tagged struct {
+	err  error
+	kind Kind
+}
+
+func (e *tagged) Error() string { return e.err.Error() }
+func (e *tagged) Unwrap() error { return e.err }
+
+// Tag 给错误附加分类标签，err 为 nil 时返回 nil
+// 错误信息保持不变，原错误仍可通过 errors.Is/As 访问
+func Tag(err error, kinds ...Kind) error {
+	for _, k := range kinds {
+		if err == nil {
+			return nil
+		}
+		err = &tagged{err: err, kind: k}
+	}
+	return err
+}
+
+// HasTag 错误链（包括 Join 的所有分支）中是否带有标签 kind
+func HasTag(err error, kind Kind) bool {
+	found := false
+	walk(err, func(e error) bool {
+		if t, ok := e.(*tagged); ok && t.kind == kind {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// Tags 返回错误链中的所有标签，按由外到内的顺序去重
+func Tags(err error) []Kind {
+	var kinds []Kind
+	seen := make(map[Kind]bool)
+	walk(err, func(e error) bool {
+		if t, ok := e.(*tagged); ok && !seen[t.kind] {
+			seen[t.kind] = true
+			kinds = append(kinds, t.kind)
+		}
+		return true
+	})
+	return kinds
+}
+
+// walk 深度优先遍历错误树，fn 返回 false 时停止
+func walk(err error, fn func(error) bool) bool {
+	if err == nil {
+		return true
+	}
+	if !fn(err) {
+		return false
+	}
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return walk(u.Unwrap(), fn)
+	case interface{ Unwrap() []error }:
+		for _, e := range u.Unwrap() {
+			if !walk(e, fn) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ==================== panic 捕获 ====================
+
+// ErrPanic 所有 *PanicError 都满足 errors.Is(err, ErrPanic)
+var ErrPanic = errors.New("panic")
+
+// PanicError 由 panic 转换而来的错误
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap panic 值本身是 error 时可以继续展开
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Is 使 errors.Is(err, ErrPanic) 成立
+func (e *PanicError) Is(target error) bool {
+	return target == ErrPanic
+}
+
+// Recover 将 panic 转换为 *PanicError 写入 *errp，必须直接由 defer 调用
+func Recover(errp *error) {
+	if r := recover(); r != nil {
+		*errp = &PanicError{Value: r, Stack: debug.Stack()}
+	}
+}
+
+// Capture 执行 fn，将 panic 转换为 *PanicError 返回
+func Capture(fn func() error) (err error) {
+	defer Recover(&err)
+	return fn()
+}
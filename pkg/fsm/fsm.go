@@ -0,0 +1,242 @@
+// Package fsm 提供泛型有限状态机。
+//
+// 转换表以声明方式给出，同一 (From, Event) 可以有多条带守卫的转换，按顺序取第一条守卫通过的：
+//
+//	m := fsm.New(Pending, []fsm.Transition[State, Event]{
+//		{From: Pending, Event: Pay, To: Paid, Guard: checkBalance},
+//		{From: Paid, Event: Ship, To: Shipped},
+//		{From: Pending, Event: Cancel, To: Cancelled},
+//	}, fsm.OnEnter(Shipped, notify))
+//
+//	err := m.Fire(ctx, Pay)
+//	f := m.FireAsync(ctx, Ship) // future.Future[State]
+//
+// 一次转换依次执行：守卫、原状态的离开动作、转换动作、新状态的进入动作，
+// 任一步返回错误时状态保持不变；全部成功后提交新状态并通知观察者。
+// 所有转换串行执行，回调中不能再调用同一状态机的 Fire。
+package fsm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+)
+
+var (
+	// ErrNoTransition 当前状态下没有该事件的转换
+	ErrNoTransition = errors.New("fsm: no transition")
+	// ErrRejected 所有候选转换都被守卫拒绝
+	ErrRejected = errors.New("fsm: rejected by guard")
+)
+
+// TransitionError 转换失败
+type TransitionError[S, E comparable] struct {
+	From  S
+	Event E
+	Err   error
+}
+
+func (e *TransitionError[S, E]) Error() string {
+	return fmt.Sprintf("%v (event %v in state %v)", e.Err, e.Event, e.From)
+}
+
+func (e *TransitionError[S, E]) Unwrap() error { return e.Err }
+
+// Change 描述一次状态转换
+type Change[S, E comparable] struct {
+	From  S
+	To    S
+	Event E
+	At    time.Time
+}
+
+// Hook 转换过程中的回调，返回错误会中止转换
+type Hook[S, E comparable] func(ctx context.Context, c Change[S, E]) error
+
+// Transition 转换表中的一项
+type Transition[S, E comparable] struct {
+	From  S
+	Event E
+	To    S
+	// Guard 可选，返回错误表示拒绝该转换
+	Guard Hook[S, E]
+	// Action 可选，在离开原状态后、进入新状态前执行
+	Action Hook[S, E]
+}
+
+// ==================== 选项 ====================
+
+// config 状态机配置
+type config[S, E comparable] struct {
+	enter     map[S][]Hook[S, E]
+	exit      map[S][]Hook[S, E]
+	observers []func(Change[S, E])
+}
+
+// Option 状态机配置选项
+type Option[S, E comparable] func(*config[S, E])
+
+// OnEnter 注册进入 state 时的动作
+func OnEnter[S, E comparable](state S, fn Hook[S, E]) Option[S, E] {
+	return func(c *config[S, E]) { c.enter[state] = append(c.enter[state], fn) }
+}
+
+// OnExit 注册离开 state 时的动作
+func OnExit[S, E comparable](state S, fn Hook[S, E]) Option[S, E] {
+	return func(c *config[S, E]) { c.exit[state] = append(c.exit[state], fn) }
+}
+
+// WithObserver 在每次转换提交后调用 fn，可用于把转换事件发布到消息总线
+// fn 在状态机的锁内同步调用，观察到的顺序与转换顺序一致
+func WithObserver[S, E comparable](fn func(Change[S, E])) Option[S, E] {
+	return func(c *config[S, E]) { c.observers = append(c.observers, fn) }
+}
+
+// ==================== 状态机 ====================
+
+// key 转换表索引
+type key[S, E comparable] struct {
+	from  S
+	event E
+}
+
+// Machine 并发安全的有限状态机
+type Machine[S, E comparable] struct {
+	cfg   config[S, E]
+	table map[key[S, E]][]Transition[S, E]
+
+	mu      sync.Mutex
+	current S
+}
+
+// New 以 initial 为初始状态创建状态机
+func New[S, E comparable](initial S, transitions []Transition[S, E], opts ...Option[S, E]) *Machine[S, E] {
+	cfg := config[S, E]{
+		enter: make(map[S][]Hook[S, E]),
+		exit:  make(map[S][]Hook[S, E]),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	m := &Machine[S, E]{
+		cfg:     cfg,
+		table:   make(map[key[S, E]][]Transition[S, E]),
+		current: initial,
+	}
+	for _, t := range transitions {
+		k := key[S, E]{t.From, t.Event}
+		m.table[k] = append(m.table[k], t)
+	}
+	return m
+}
+
+// Current 返回当前状态
+func (m *Machine[S, E]) Current() S {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.current
+}
+
+// Is 判断当前是否处于 state
+func (m *Machine[S, E]) Is(state S) bool {
+	return m.Current() == state
+}
+
+// Can 判断当前状态下是否存在 event 的转换（不执行守卫）
+func (m *Machine[S, E]) Can(event E) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.table[key[S, E]{m.current, event}]) > 0
+}
+
+// Events 返回当前状态下存在转换的事件
+func (m *Machine[S, E]) Events() []E {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var events []E
+	for k := range m.table {
+		if k.from == m.current {
+			events = append(events, k.event)
+		}
+	}
+	return events
+}
+
+// Fire 触发事件并同步执行转换
+func (m *Machine[S, E]) Fire(ctx context.Context, event E) error {
+	_, err := m.fire(ctx, event)
+	return err
+}
+
+// FireAsync 异步触发事件，Future 的结果为转换后的状态
+// ctx 在转换开始前取消时不会执行任何回调
+func (m *Machine[S, E]) FireAsync(ctx context.Context, event E) future.Future[S] {
+	return future.NewWithContextE(ctx, func() (S, error) {
+		return m.fire(ctx, event)
+	})
+}
+
+func (m *Machine[S, E]) fire(ctx context.Context, event E) (S, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	from := m.current
+	fail := func(err error) (S, error) {
+		return from, &TransitionError[S, E]{From: from, Event: event, Err: err}
+	}
+	if err := ctx.Err(); err != nil {
+		return fail(err)
+	}
+
+	candidates := m.table[key[S, E]{from, event}]
+	if len(candidates) == 0 {
+		return fail(ErrNoTransition)
+	}
+
+	var (
+		chosen    *Transition[S, E]
+		change    Change[S, E]
+		rejection []error
+	)
+	for i := range candidates {
+		t := &candidates[i]
+		c := Change[S, E]{From: from, To: t.To, Event: event, At: time.Now()}
+		if t.Guard != nil {
+			if err := t.Guard(ctx, c); err != nil {
+				rejection = append(rejection, err)
+				continue
+			}
+		}
+		chosen, change = t, c
+		break
+	}
+	if chosen == nil {
+		return fail(fmt.Errorf("%w: %w", ErrRejected, errors.Join(rejection...)))
+	}
+
+	for _, fn := range m.cfg.exit[from] {
+		if err := fn(ctx, change); err != nil {
+			return fail(err)
+		}
+	}
+	if chosen.Action != nil {
+		if err := chosen.Action(ctx, change); err != nil {
+			return fail(err)
+		}
+	}
+	for _, fn := range m.cfg.enter[change.To] {
+		if err := fn(ctx, change); err != nil {
+			return fail(err)
+		}
+	}
+
+	m.current = change.To
+	for _, fn := range m.cfg.observers {
+		fn(change)
+	}
+	return change.To, nil
+}
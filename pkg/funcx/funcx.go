@@ -0,0 +1,343 @@
+package funcx
+
+// ==================== 管道 ====================
+
+// Pipe2 从左到右组合 2 个函数
+func Pipe2[A, B, C any](f1 func(A) B, f2 func(B) C) func(A) C {
+	return func(a A) C {
+		return f2(f1(a))
+	}
+}
+
+// Pipe3 从左到右组合 3 个函数
+func Pipe3[A, B, C, D any](f1 func(A) B, f2 func(B) C, f3 func(C) D) func(A) D {
+	return func(a A) D {
+		return f3(f2(f1(a)))
+	}
+}
+
+// Pipe4 从左到右组合 4 个函数
+func Pipe4[A, B, C, D, E any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) func(A) E {
+	return func(a A) E {
+		return f4(f3(f2(f1(a))))
+	}
+}
+
+// Pipe5 从左到右组合 5 个函数
+func Pipe5[A, B, C, D, E, F any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F) func(A) F {
+	return func(a A) F {
+		return f5(f4(f3(f2(f1(a)))))
+	}
+}
+
+// Pipe6 从左到右组合 6 个函数
+func Pipe6[A, B, C, D, E, F, G any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G) func(A) G {
+	return func(a A) G {
+		return f6(f5(f4(f3(f2(f1(a))))))
+	}
+}
+
+// Pipe7 从左到右组合 7 个函数
+func Pipe7[A, B, C, D, E, F, G, H any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H) func(A) H {
+	return func(a A) H {
+		return f7(f6(f5(f4(f3(f2(f1(a)))))))
+	}
+}
+
+// Pipe8 从左到右组合 8 个函数
+func Pipe8[A, B, C, D, E, F, G, H, I any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I) func(A) I {
+	return func(a A) I {
+		return f8(f7(f6(f5(f4(f3(f2(f1(a))))))))
+	}
+}
+
+// Compose 从右到左组合两个函数：Compose(f, g)(x) == f(g(x))
+func Compose[A, B, C any](f func(B) C, g func(A) B) func(A) C {
+	return func(a A) C {
+		return f(g(a))
+	}
+}
+
+// Identity 原样返回参数
+func Identity[T any](v T) T { return v }
+
+// Const 返回始终返回 v 的函数
+func Const[A, T any](v T) func(A) T {
+	return func(A) T { return v }
+}
+
+// ==================== 带错误的管道 ====================
+
+// PipeE2 从左到右组合 2 个可能失败的函数，遇到错误立即返回
+func PipeE2[A, B, C any](f1 func(A) (B, error), f2 func(B) (C, error)) func(A) (C, error) {
+	return func(a A) (C, error) {
+		v1, err := f1(a)
+		if err != nil {
+			var zero C
+			return zero, err
+		}
+		return f2(v1)
+	}
+}
+
+// PipeE3 从左到右组合 3 个可能失败的函数，遇到错误立即返回
+func PipeE3[A, B, C, D any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error)) func(A) (D, error) {
+	return func(a A) (D, error) {
+		v1, err := f1(a)
+		if err != nil {
+			var zero D
+			return zero, err
+		}
+		v2, err := f2(v1)
+		if err != nil {
+			var zero D
+			return zero, err
+		}
+		return f3(v2)
+	}
+}
+
+// PipeE4 从左到右组合 4 个可能失败的函数，遇到错误立即返回
+func PipeE4[A, B, C, D, E any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error)) func(A) (E, error) {
+	return func(a A) (E, error) {
+		v1, err := f1(a)
+		if err != nil {
+			var zero E
+			return zero, err
+		}
+		v2, err := f2(v1)
+		if err != nil {
+			var zero E
+			return zero, err
+		}
+		v3, err := f3(v2)
+		if err != nil {
+			var zero E
+			return zero, err
+		}
+		return f4(v3)
+	}
+}
+
+// PipeE5 从左到右组合 5 个可能失败的函数，遇到错误立即返回
+func PipeE5[A, B, C, D, E, F any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error)) func(A) (F, error) {
+	return func(a A) (F, error) {
+		v1, err := f1(a)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		v2, err := f2(v1)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		v3, err := f3(v2)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		v4, err := f4(v3)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		return f5(v4)
+	}
+}
+
+// PipeE6 从左到右组合 6 个可能失败的函数，遇到错误立即返回
+func PipeE6[A, B, C, D, E, F, G any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error), f6 func(F) (G, error)) func(A) (G, error) {
+	return func(a A) (G, error) {
+		v1, err := f1(a)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		v2, err := f2(v1)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		v3, err := f3(v2)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		v4, err := f4(v3)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		v5, err := f5(v4)
+		if err != nil {
+			var zero G
+			return zero, err
+		}
+		return f6(v5)
+	}
+}
+
+// PipeE7 从左到右组合 7 个可能失败的函数，遇到错误立即返回
+func PipeE7[A, B, C, D, E, F, G, H any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error), f6 func(F) (G, error), f7 func(G) (H, error)) func(A) (H, error) {
+	return func(a A) (H, error) {
+		v1, err := f1(a)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		v2, err := f2(v1)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		v3, err := f3(v2)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		v4, err := f4(v3)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		v5, err := f5(v4)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		v6, err := f6(v5)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		return f7(v6)
+	}
+}
+
+// PipeE8 从左到右组合 8 个可能失败的函数，遇到错误立即返回
+func PipeE8[A, B, C, D, E, F, G, H, I any](f1 func(A) (B, error), f2 func(B) (C, error), f3 func(C) (D, error), f4 func(D) (E, error), f5 func(E) (F, error), f6 func(F) (G, error), f7 func(G) (H, error), f8 func(H) (I, error)) func(A) (I, error) {
+	return func(a A) (I, error) {
+		v1, err := f1(a)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		v2, err := f2(v1)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		v3, err := f3(v2)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		v4, err := f4(v3)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		v5, err := f5(v4)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		v6, err := f6(v5)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		v7, err := f7(v6)
+		if err != nil {
+			var zero I
+			return zero, err
+		}
+		return f8(v7)
+	}
+}
+
+// ComposeE 从右到左组合两个可能失败的函数
+func ComposeE[A, B, C any](f func(B) (C, error), g func(A) (B, error)) func(A) (C, error) {
+	return PipeE2(g, f)
+}
+
+// Lift 将普通函数提升为不会失败的带错误函数，便于放入 PipeE 管道
+func Lift[A, B any](f func(A) B) func(A) (B, error) {
+	return func(a A) (B, error) {
+		return f(a), nil
+	}
+}
+
+// ==================== 柯里化 ====================
+
+// Curry2 将二元函数柯里化
+func Curry2[A, B, R any](f func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return func(b B) R {
+			return f(a, b)
+		}
+	}
+}
+
+// Curry3 将三元函数柯里化
+func Curry3[A, B, C, R any](f func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return func(b B) func(C) R {
+			return func(c C) R {
+				return f(a, b, c)
+			}
+		}
+	}
+}
+
+// Uncurry2 将柯里化的二元函数还原
+func Uncurry2[A, B, R any](f func(A) func(B) R) func(A, B) R {
+	return func(a A, b B) R {
+		return f(a)(b)
+	}
+}
+
+// Uncurry3 将柯里化的三元函数还原
+func Uncurry3[A, B, C, R any](f func(A) func(B) func(C) R) func(A, B, C) R {
+	return func(a A, b B, c C) R {
+		return f(a)(b)(c)
+	}
+}
+
+// Flip 交换二元函数的参数顺序
+func Flip[A, B, R any](f func(A, B) R) func(B, A) R {
+	return func(b B, a A) R {
+		return f(a, b)
+	}
+}
+
+// ==================== 偏应用 ====================
+
+// Partial 固定二元函数的第一个参数
+func Partial[A, B, R any](f func(A, B) R, a A) func(B) R {
+	return func(b B) R {
+		return f(a, b)
+	}
+}
+
+// PartialRight 固定二元函数的第二个参数
+func PartialRight[A, B, R any](f func(A, B) R, b B) func(A) R {
+	return func(a A) R {
+		return f(a, b)
+	}
+}
+
+// Partial3 固定三元函数的第一个参数
+func Partial3[A, B, C, R any](f func(A, B, C) R, a A) func(B, C) R {
+	return func(b B, c C) R {
+		return f(a, b, c)
+	}
+}
+
+// PartialE 固定可能失败的二元函数的第一个参数
+func PartialE[A, B, R any](f func(A, B) (R, error), a A) func(B) (R, error) {
+	return func(b B) (R, error) {
+		return f(a, b)
+	}
+}
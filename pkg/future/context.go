@@ -0,0 +1,23 @@
+package future
+
+import "context"
+
+// AsContext 返回在 f 完成时结束的 context
+// f 出错（包括被取消）时 context.Cause 返回该错误，成功时为 context.Canceled
+func AsContext[T any](f Future[T]) context.Context {
+    ctx, cancel := context.WithCancelCause(context.Background())
+    f.OnComplete(func(_ T, err error) { cancel(err) })
+    return ctx
+}
+
+// Await 等待 f 完成或 ctx 结束，以先发生者为准
+// ctx 先结束时返回 ctx.Err()，f 不会被取消
+func Await[T any](ctx context.Context, f Future[T]) (T, error) {
+    select {
+    case <-f.Done():
+        return f.GetE()
+    case <-ctx.Done():
+        var zero T
+        return zero, ctx.Err()
+    }
+}
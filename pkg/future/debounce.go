@@ -0,0 +1,65 @@
+package future
+
+import (
+    "sync"
+    "time"
+)
+
+// Debounce 返回防抖函数：每次调用都会把 fn 的执行推迟到最后一次调用之后 d，
+// 期间的所有调用共享同一个Future，得到那一次执行的结果
+func Debounce[T any](d time.Duration, fn func() T) func() Future[T] {
+    var (
+        mu      sync.Mutex
+        pending *Promise[T]
+        timer   *time.Timer
+    )
+    fire := func() {
+        mu.Lock()
+        p := pending
+        pending = nil
+        mu.Unlock()
+        if p == nil {
+            return
+        }
+        New(fn).OnComplete(func(v T, err error) {
+            if err != nil {
+                p.Fail(err)
+                return
+            }
+            p.Complete(v)
+        })
+    }
+    return func() Future[T] {
+        mu.Lock()
+        defer mu.Unlock()
+        if pending == nil {
+            pending = NewPromise[T]()
+        }
+        if timer == nil {
+            timer = time.AfterFunc(d, fire)
+        } else {
+            timer.Reset(d)
+        }
+        return pending.Future()
+    }
+}
+
+// Throttle 返回节流函数：fn 正在执行或距上次开始不足 d 时，调用直接返回当前的Future，
+// 否则立即开始一次新的执行
+func Throttle[T any](d time.Duration, fn func() T) func() Future[T] {
+    var (
+        mu      sync.Mutex
+        current Future[T]
+        started time.Time
+    )
+    return func() Future[T] {
+        mu.Lock()
+        defer mu.Unlock()
+        if current != nil && (!current.IsDone() || time.Since(started) < d) {
+            return current
+        }
+        current = New(fn)
+        started = time.Now()
+        return current
+    }
+}
@@ -0,0 +1,92 @@
+package future
+
+import (
+    "fmt"
+    "io"
+    "runtime"
+    "slices"
+    "sync"
+    "sync/atomic"
+    "time"
+)
+
+// pendingEntry 追踪中的未完成Future
+type pendingEntry struct {
+    name    string
+    created time.Time
+    stack   []uintptr
+    state   func() State
+}
+
+var (
+    tracking  atomic.Bool
+    pendingMu sync.Mutex
+    pending   = make(map[*pendingEntry]struct{})
+)
+
+// SetTracking 开启或关闭未完成Future的追踪，默认关闭
+// 开启后每个新建的Future都会记录创建时间和调用栈，供 DumpPending 输出；关闭前已追踪的不受影响
+func SetTracking(enabled bool) {
+    tracking.Store(enabled)
+}
+
+// trackPending 在开启追踪时记录创建信息，未开启时返回 nil
+func trackPending(name string, state func() State) *pendingEntry {
+    if !tracking.Load() {
+        return nil
+    }
+    pcs := make([]uintptr, 32)
+    // 跳过 runtime.Callers、trackPending 和 newImpl
+    n := runtime.Callers(3, pcs)
+    e := &pendingEntry{name: name, created: time.Now(), stack: pcs[:n], state: state}
+    pendingMu.Lock()
+    pending[e] = struct{}{}
+    pendingMu.Unlock()
+    return e
+}
+
+func untrackPending(e *pendingEntry) {
+    if e == nil {
+        return
+    }
+    pendingMu.Lock()
+    delete(pending, e)
+    pendingMu.Unlock()
+}
+
+// DumpPending 把追踪中的未完成Future按存在时间从长到短写入 w，包括名称、状态和创建调用栈
+// 需要先通过 SetTracking(true) 开启追踪
+func DumpPending(w io.Writer) error {
+    pendingMu.Lock()
+    entries := make([]*pendingEntry, 0, len(pending))
+    for e := range pending {
+        entries = append(entries, e)
+    }
+    pendingMu.Unlock()
+
+    slices.SortFunc(entries, func(a, b *pendingEntry) int {
+        return a.created.Compare(b.created)
+    })
+
+    now := time.Now()
+    for _, e := range entries {
+        name := e.name
+        if name == "" {
+            name = "<unnamed>"
+        }
+        if _, err := fmt.Fprintf(w, "future %s: %s for %s\n", name, e.state(), now.Sub(e.created).Round(time.Millisecond)); err != nil {
+            return err
+        }
+        frames := runtime.CallersFrames(e.stack)
+        for {
+            frame, more := frames.Next()
+            if _, err := fmt.Fprintf(w, "    %s\n        %s:%d\n", frame.Function, frame.File, frame.Line); err != nil {
+                return err
+            }
+            if !more {
+                break
+            }
+        }
+    }
+    return nil
+}
@@ -0,0 +1,378 @@
+package future
+
+import (
+    "container/heap"
+    "context"
+    "errors"
+    "sync"
+
+    "github.com/hunter-hongg/GoPlus/pkg/metrics"
+)
+
+// ErrExecutorShutdown 执行器已关闭，不再接受新任务
+var ErrExecutorShutdown = errors.New("future: executor shut down")
+
+// ErrQueueFull 执行器队列已满，任务被拒绝或被丢弃
+var ErrQueueFull = errors.New("future: executor queue full")
+
+// ==================== 优先级 ====================
+
+// Priority 任务优先级，数值越大越先执行
+type Priority int
+
+const (
+    // PriorityLow 后台批量任务
+    PriorityLow Priority = -1
+    // PriorityNormal 默认优先级
+    PriorityNormal Priority = 0
+    // PriorityHigh 延迟敏感的任务
+    PriorityHigh Priority = 1
+)
+
+// task 排队中的任务
+// key = seq - priority*window：优先级每高一级最多插队 window 个任务，
+// 因此低优先级任务最多被之后提交的 window*优先级差 个任务超越，不会饿死
+type task struct {
+    key int64
+    seq int64
+    run func()
+    f   queuedFuture
+}
+
+// queuedFuture 排队任务对应的Future，用于取消或直接以错误完成
+type queuedFuture interface {
+    Cancel()
+    reject(err error)
+}
+
+// taskQueue 按 key、seq 排序的小顶堆
+type taskQueue []*task
+
+func (q taskQueue) Len() int { return len(q) }
+
+func (q taskQueue) Less(i, j int) bool {
+    if q[i].key != q[j].key {
+        return q[i].key < q[j].key
+    }
+    return q[i].seq < q[j].seq
+}
+
+func (q taskQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *taskQueue) Push(x any) { *q = append(*q, x.(*task)) }
+
+func (q *taskQueue) Pop() any {
+    old := *q
+    n := len(old)
+    t := old[n-1]
+    old[n-1] = nil
+    *q = old[:n-1]
+    return t
+}
+
+// ==================== 队列策略 ====================
+
+// QueuePolicy 队列达到上限时的处理方式
+type QueuePolicy int
+
+const (
+    // QueueBlock 阻塞提交者直到队列有空位
+    QueueBlock QueuePolicy = iota
+    // QueueReject 新任务以 ErrQueueFull 失败
+    QueueReject
+    // QueueDropOldest 丢弃最早提交的排队任务（以 ErrQueueFull 失败），接受新任务
+    QueueDropOldest
+)
+
+// ==================== 执行器 ====================
+
+// executorConfig 执行器配置
+type executorConfig struct {
+    window      int64
+    hooks       *Hooks
+    synchronous bool
+    queueLimit  int
+    policy      QueuePolicy
+    name        string
+}
+
+// ExecutorOption 执行器配置选项
+type ExecutorOption func(*executorConfig)
+
+// WithStarvationWindow 设置优先级每高一级可以插队的任务数，默认 64
+// 值越小低优先级任务等待越短，值越大优先级区分越明显
+func WithStarvationWindow(n int) ExecutorOption {
+    return func(c *executorConfig) { c.window = int64(n) }
+}
+
+// WithHooks 为该执行器上的任务使用 h，代替全局钩子
+func WithHooks(h Hooks) ExecutorOption {
+    return func(c *executorConfig) { c.hooks = &h }
+}
+
+// WithSynchronous 让任务在提交者的 goroutine 中立即执行，提交返回时任务已经完成
+// 不会启动工作 goroutine，优先级也不再起作用，主要用于测试
+func WithSynchronous() ExecutorOption {
+    return func(c *executorConfig) { c.synchronous = true }
+}
+
+// WithQueueLimit 限制排队任务数为 n（<=0 表示不限制），队列满时按 policy 处理
+func WithQueueLimit(n int, policy QueuePolicy) ExecutorOption {
+    return func(c *executorConfig) {
+        c.queueLimit = n
+        c.policy = policy
+    }
+}
+
+// WithExecutorName 设置上报指标时使用的 name 标签
+// 指标：executor_queue_depth、executor_rejected_total、executor_dropped_total
+func WithExecutorName(name string) ExecutorOption {
+    return func(c *executorConfig) { c.name = name }
+}
+
+// Executor 固定数量 goroutine 的工作池，提交的任务按优先级排队等待空闲的工作 goroutine
+// 默认队列不设上限，提交不会阻塞；通过 WithQueueLimit 设置上限和满时的策略
+type Executor struct {
+    cfg executorConfig
+
+    mu      sync.Mutex
+    cond    *sync.Cond
+    // space 队列有空位或执行器关闭时通知 QueueBlock 下阻塞的提交者
+    space   *sync.Cond
+    queue   taskQueue
+    seq     int64
+    running map[*task]struct{}
+    closed  bool
+    // drained 关闭后队列清空且没有运行中的任务时关闭
+    drained chan struct{}
+}
+
+// ShutdownReport Shutdown 的结果
+type ShutdownReport struct {
+    // Drained 关闭期间正常执行完的任务数
+    Drained int
+    // Cancelled ctx 结束时仍在排队或运行、因而被取消的任务数
+    Cancelled int
+}
+
+// NewExecutor 创建包含 workers 个工作 goroutine 的执行器，workers 至少为 1
+func NewExecutor(workers int, opts ...ExecutorOption) *Executor {
+    if workers < 1 {
+        workers = 1
+    }
+    cfg := executorConfig{window: 64}
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+    e := &Executor{
+        cfg:     cfg,
+        running: make(map[*task]struct{}),
+        drained: make(chan struct{}),
+    }
+    e.cond = sync.NewCond(&e.mu)
+    e.space = sync.NewCond(&e.mu)
+    if cfg.synchronous {
+        workers = 0
+    }
+    for i := 0; i < workers; i++ {
+        go e.worker()
+    }
+    return e
+}
+
+// Submit 以 PriorityNormal 提交无返回值的任务
+func (e *Executor) Submit(fn func()) Future[struct{}] {
+    return e.SubmitWithPriority(PriorityNormal, fn)
+}
+
+// SubmitWithPriority 以指定优先级提交无返回值的任务
+func (e *Executor) SubmitWithPriority(p Priority, fn func()) Future[struct{}] {
+    return NewOnWithPriority(e, p, func() struct{} {
+        fn()
+        return struct{}{}
+    })
+}
+
+// enqueue 把任务加入队列并唤醒一个工作 goroutine
+// 执行器已关闭时返回 ErrExecutorShutdown，队列已满且策略为 QueueReject 时返回 ErrQueueFull
+func (e *Executor) enqueue(p Priority, run func(), f queuedFuture) error {
+    e.mu.Lock()
+    if e.closed {
+        e.mu.Unlock()
+        return ErrExecutorShutdown
+    }
+    if e.cfg.synchronous {
+        e.mu.Unlock()
+        run()
+        return nil
+    }
+
+    var dropped *task
+    if limit := e.cfg.queueLimit; limit > 0 && len(e.queue) >= limit {
+        switch e.cfg.policy {
+        case QueueReject:
+            e.mu.Unlock()
+            e.counter("executor_rejected_total").Inc()
+            return ErrQueueFull
+        case QueueDropOldest:
+            dropped = e.removeOldestLocked()
+        default:
+            for !e.closed && len(e.queue) >= limit {
+                e.space.Wait()
+            }
+            if e.closed {
+                e.mu.Unlock()
+                return ErrExecutorShutdown
+            }
+        }
+    }
+
+    e.seq++
+    heap.Push(&e.queue, &task{
+        key: e.seq - int64(p)*e.cfg.window,
+        seq: e.seq,
+        run: run,
+        f:   f,
+    })
+    // 在锁内上报，保证深度按顺序更新
+    e.gauge().Set(float64(len(e.queue)))
+    e.mu.Unlock()
+    e.cond.Signal()
+
+    if dropped != nil {
+        e.counter("executor_dropped_total").Inc()
+        dropped.f.reject(ErrQueueFull)
+    }
+    return nil
+}
+
+// removeOldestLocked 移除 seq 最小的排队任务
+func (e *Executor) removeOldestLocked() *task {
+    oldest := 0
+    for i, t := range e.queue {
+        if t.seq < e.queue[oldest].seq {
+            oldest = i
+        }
+    }
+    return heap.Remove(&e.queue, oldest).(*task)
+}
+
+func (e *Executor) worker() {
+    for {
+        e.mu.Lock()
+        for len(e.queue) == 0 && !e.closed {
+            e.cond.Wait()
+        }
+        if len(e.queue) == 0 {
+            e.mu.Unlock()
+            return
+        }
+        t := heap.Pop(&e.queue).(*task)
+        e.running[t] = struct{}{}
+        e.gauge().Set(float64(len(e.queue)))
+        e.mu.Unlock()
+        e.space.Signal()
+
+        t.run()
+
+        e.mu.Lock()
+        delete(e.running, t)
+        e.checkDrainedLocked()
+        e.mu.Unlock()
+    }
+}
+
+func (e *Executor) gauge() metrics.Gauge {
+    return metrics.Default().Gauge("executor_queue_depth", metrics.L("name", e.cfg.name))
+}
+
+func (e *Executor) counter(name string) metrics.Counter {
+    return metrics.Default().Counter(name, metrics.L("name", e.cfg.name))
+}
+
+func (e *Executor) checkDrainedLocked() {
+    if e.closed && len(e.queue) == 0 && len(e.running) == 0 {
+        select {
+        case <-e.drained:
+        default:
+            close(e.drained)
+        }
+    }
+}
+
+// Shutdown 停止接受新任务，并等待排队和运行中的任务执行完
+// ctx 结束时取消剩余任务并返回 ctx.Err()；运行中的任务只会收到取消信号，Shutdown 不再等待它们
+func (e *Executor) Shutdown(ctx context.Context) (ShutdownReport, error) {
+    e.mu.Lock()
+    pending := len(e.queue) + len(e.running)
+    e.closed = true
+    e.checkDrainedLocked()
+    e.mu.Unlock()
+    e.cond.Broadcast()
+    e.space.Broadcast()
+
+    select {
+    case <-e.drained:
+        return ShutdownReport{Drained: pending}, nil
+    case <-ctx.Done():
+    }
+
+    e.mu.Lock()
+    // 排队的任务被取消后仍由工作 goroutine 取出，以便其Future以取消错误完成
+    cancelled := len(e.queue) + len(e.running)
+    for _, t := range e.queue {
+        t.f.Cancel()
+    }
+    for t := range e.running {
+        t.f.Cancel()
+    }
+    e.mu.Unlock()
+    return ShutdownReport{Drained: pending - cancelled, Cancelled: cancelled}, ctx.Err()
+}
+
+// ==================== 在执行器上创建Future ====================
+
+// NewOn 在执行器上运行 fn，任务开始前被取消时不会执行
+func NewOn[T any](e *Executor, fn func() T) Future[T] {
+    return NewOnWithPriority(e, PriorityNormal, fn)
+}
+
+// NewOnWithPriority 以指定优先级在执行器上运行 fn
+func NewOnWithPriority[T any](e *Executor, p Priority, fn func() T) Future[T] {
+    f := newImpl[T](context.Background())
+    f.hooks = e.cfg.hooks
+    if err := e.enqueue(p, func() { f.execute(fn) }, f); err != nil {
+        f.reject(err)
+    }
+    return f
+}
+
+// NewOnE 在执行器上运行返回(T, error)的 fn
+func NewOnE[T any](e *Executor, fn func() (T, error)) Future[T] {
+    return NewOnWithContextE(e, context.Background(), fn)
+}
+
+// NewOnWithContextE 在执行器上运行带Context的(T, error)任务
+func NewOnWithContextE[T any](e *Executor, ctx context.Context, fn func() (T, error)) Future[T] {
+    return NewOnCtx(e, ctx, func(context.Context) (T, error) { return fn() })
+}
+
+// NewOnCtx 与 NewCtx 相同，但在执行器上运行：fn 接收Future自身的 ctx，Cancel() 可以打断正在运行的任务
+func NewOnCtx[T any](e *Executor, ctx context.Context, fn func(ctx context.Context) (T, error)) Future[T] {
+    f := newImpl[T](ctx)
+    f.hooks = e.cfg.hooks
+    run := func() {
+        f.executeWithError(func() (T, error) { return fn(f.ctx) })
+    }
+    if err := e.enqueue(PriorityNormal, run, f); err != nil {
+        f.reject(err)
+    }
+    return f
+}
+
+// reject 不运行任务，直接以 err 完成
+func (f *futureImpl[T]) reject(err error) {
+    f.err = err
+    f.cancelFunc()
+    f.finish()
+}
@@ -0,0 +1,27 @@
+// Package fakeclock 提供可手动推进的 future.Clock，用于确定性地测试超时逻辑。
+//
+//	clk := fakeclock.New(time.Now())
+//	f := future.New(slow, future.WithClock(clk))
+//	go func() {
+//		clk.BlockUntil(1)
+//		clk.Advance(time.Second)
+//	}()
+//	_, ok := f.GetWithTimeout(time.Second) // ok == false，无需真实等待
+package fakeclock
+
+import (
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+	"github.com/hunter-hongg/GoPlus/pkg/testx"
+)
+
+// Clock 手动推进的时钟，与 testx.FakeClock 相同
+type Clock = testx.FakeClock
+
+var _ future.Clock = (*Clock)(nil)
+
+// New 创建起始于 start 的时钟
+func New(start time.Time) *Clock {
+	return testx.NewFakeClock(start)
+}
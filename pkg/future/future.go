@@ -2,39 +2,73 @@ package future
 
 import (
     "context"
+    "errors"
+    "sync"
     "time"
+
+    "github.com/hunter-hongg/GoPlus/pkg/errorsx"
+    "github.com/hunter-hongg/GoPlus/pkg/option"
 )
 
+// ==================== 错误定义 ====================
+
+// ErrNoFutures 组合函数没有收到任何Future
+var ErrNoFutures = errors.New("future: no futures")
+
+// ErrTimeout Future 未在限定时间内完成
+var ErrTimeout = errors.New("future: timeout")
+
+// ErrPanic 任务函数发生 panic，可通过 errors.Is(f.Error(), ErrPanic) 判断
+// 错误的具体类型为 *errorsx.PanicError，包含 panic 值和调用栈
+var ErrPanic = errorsx.ErrPanic
+
 // ==================== 接口定义 ====================
 
 // Future 单返回值Future接口
 type Future[T any] interface {
     Get() T
+    GetE() (T, error)
     GetWithTimeout(timeout time.Duration) (T, bool)
     Wait(timeout ...time.Duration) bool
     IsDone() bool
+    Done() <-chan struct{}
     Cancel()
     Error() error
+    // State 返回当前状态，可用于区分被取消与正常完成
+    State() State
+
+    // OnComplete 注册完成回调，已完成时立即在当前 goroutine 调用，否则在完成任务的 goroutine 中调用
+    OnComplete(fn func(T, error)) Future[T]
+    // OnSuccess 注册成功回调，语义同 OnComplete
+    OnSuccess(fn func(T)) Future[T]
+    // OnError 注册失败回调，语义同 OnComplete
+    OnError(fn func(error)) Future[T]
 }
 
 // Future2 双返回值Future接口
 type Future2[T1, T2 any] interface {
     Get() (T1, T2)
+    Get2E() (T1, T2, error)
     GetWithTimeout(timeout time.Duration) (T1, T2, bool)
     Wait(timeout ...time.Duration) bool
     IsDone() bool
+    Done() <-chan struct{}
     Cancel()
     Error() error
+    State() State
 }
 
 // Future3 三返回值Future接口
 type Future3[T1, T2, T3 any] interface {
     Get() (T1, T2, T3)
+    Get3E() (T1, T2, T3, error)
     GetWithTimeout(timeout time.Duration) (T1, T2, T3, bool)
     Wait(timeout ...time.Duration) bool
     IsDone() bool
+    Done() <-chan struct{}
     Cancel()
     Error() error
+    State() State
 }
 
 // ==================== 实现结构体 ====================
@@ -46,6 +80,16 @@ type futureImpl[T any] struct {
     result     T
     done       chan struct{}
     err        error
+    opts       options
+    hooks      *Hooks
+    // lifecycle 的状态在 result、err 写入之后、done 关闭之前原子地设置为结束状态，
+    // 因此观察到结束状态的调用方也能看到结果
+    lifecycle
+    // track 开启追踪时记录的创建信息，完成时移除
+    track *pendingEntry
+
+    mu        sync.Mutex
+    callbacks []func()
 }
 
 // futureImpl2 双返回值实现
@@ -56,6 +100,8 @@ type futureImpl2[T1, T2 any] struct {
     result2    T2
     done       chan struct{}
     err        error
+    opts       options
+    lifecycle
 }
 
 // futureImpl3 三返回值实现
@@ -67,40 +113,54 @@ type futureImpl3[T1, T2, T3 any] struct {
     result3    T3
     done       chan struct{}
     err        error
+    opts       options
+    lifecycle
 }
 
 // ==================== 构造函数 ====================
 
 // New 创建单返回值Future
-func New[T any](fn func() T) Future[T] {
-    return NewWithContext[T](context.Background(), fn)
+func New[T any](fn func() T, opts ...Option) Future[T] {
+    return NewWithContext[T](context.Background(), fn, opts...)
 }
 
 // NewWithContext 创建带Context的单返回值Future
-func NewWithContext[T any](ctx context.Context, fn func() T) Future[T] {
-    childCtx, cancel := context.WithCancel(ctx)
-    f := &futureImpl[T]{
-        ctx:        childCtx,
-        cancelFunc: cancel,
-        done:       make(chan struct{}),
-    }
-    
+func NewWithContext[T any](ctx context.Context, fn func() T, opts ...Option) Future[T] {
+    f := newImpl[T](ctx, opts...)
     go f.execute(fn)
     return f
 }
 
+// NewWithDeadline 创建带截止时间的单返回值Future，fn 通过 ctx 感知截止时间
+// 截止时间在完成前到达时，Error() 返回 context.DeadlineExceeded
+func NewWithDeadline[T any](deadline time.Time, fn func(ctx context.Context) T, opts ...Option) Future[T] {
+    ctx, cancel := context.WithDeadline(context.Background(), deadline)
+    f := newImpl[T](ctx, opts...)
+
+    go func() {
+        // 任务结束后立即释放截止时间 ctx 的定时器，而不是等到截止时间
+        defer cancel()
+        f.executeWithError(func() (T, error) {
+            result := fn(f.ctx)
+            return result, f.ctx.Err()
+        })
+    }()
+    return f
+}
+
 // New2 创建双返回值Future
-func New2[T1, T2 any](fn func() (T1, T2)) Future2[T1, T2] {
-    return New2WithContext[T1, T2](context.Background(), fn)
+func New2[T1, T2 any](fn func() (T1, T2), opts ...Option) Future2[T1, T2] {
+    return New2WithContext[T1, T2](context.Background(), fn, opts...)
 }
 
 // New2WithContext 创建带Context的双返回值Future
-func New2WithContext[T1, T2 any](ctx context.Context, fn func() (T1, T2)) Future2[T1, T2] {
+func New2WithContext[T1, T2 any](ctx context.Context, fn func() (T1, T2), opts ...Option) Future2[T1, T2] {
     childCtx, cancel := context.WithCancel(ctx)
     f := &futureImpl2[T1, T2]{
         ctx:        childCtx,
         cancelFunc: cancel,
         done:       make(chan struct{}),
+        opts:       applyOptions(opts),
     }
     
     go f.execute(fn)
@@ -108,17 +168,18 @@ func New2WithContext[T1, T2 any](ctx context.Context, fn func() (T1, T2)) Future
 }
 
 // New3 创建三返回值Future
-func New3[T1, T2, T3 any](fn func() (T1, T2, T3)) Future3[T1, T2, T3] {
-    return New3WithContext[T1, T2, T3](context.Background(), fn)
+func New3[T1, T2, T3 any](fn func() (T1, T2, T3), opts ...Option) Future3[T1, T2, T3] {
+    return New3WithContext[T1, T2, T3](context.Background(), fn, opts...)
 }
 
 // New3WithContext 创建带Context的三返回值Future
-func New3WithContext[T1, T2, T3 any](ctx context.Context, fn func() (T1, T2, T3)) Future3[T1, T2, T3] {
+func New3WithContext[T1, T2, T3 any](ctx context.Context, fn func() (T1, T2, T3), opts ...Option) Future3[T1, T2, T3] {
     childCtx, cancel := context.WithCancel(ctx)
     f := &futureImpl3[T1, T2, T3]{
         ctx:        childCtx,
         cancelFunc: cancel,
         done:       make(chan struct{}),
+        opts:       applyOptions(opts),
     }
     
     go f.execute(fn)
@@ -126,37 +187,60 @@ func New3WithContext[T1, T2, T3 any](ctx context.Context, fn func() (T1, T2, T3)
 }
 
 // NewE 创建返回(T, error)的Future
-func NewE[T any](fn func() (T, error)) Future[T] {
-    return NewWithContextE[T](context.Background(), fn)
+func NewE[T any](fn func() (T, error), opts ...Option) Future[T] {
+    return NewWithContextE[T](context.Background(), fn, opts...)
 }
 
 // NewWithContextE 创建带Context的(T, error) Future
-func NewWithContextE[T any](ctx context.Context, fn func() (T, error)) Future[T] {
+func NewWithContextE[T any](ctx context.Context, fn func() (T, error), opts ...Option) Future[T] {
+    f := newImpl[T](ctx, opts...)
+    go f.executeWithError(fn)
+    return f
+}
+
+// NewCtx 创建(T, error) Future，fn 接收Future自身的 ctx，Cancel() 可以打断正在运行的任务
+func NewCtx[T any](ctx context.Context, fn func(ctx context.Context) (T, error), opts ...Option) Future[T] {
+    f := newImpl[T](ctx, opts...)
+    go f.executeWithError(func() (T, error) {
+        return fn(f.ctx)
+    })
+    return f
+}
+
+// New2E 创建返回(T1, T2, error)的Future
+func New2E[T1, T2 any](fn func() (T1, T2, error), opts ...Option) Future2[T1, T2] {
+    return New2WithContextE[T1, T2](context.Background(), fn, opts...)
+}
+
+// New2WithContextE 创建带Context的(T1, T2, error) Future
+func New2WithContextE[T1, T2 any](ctx context.Context, fn func() (T1, T2, error), opts ...Option) Future2[T1, T2] {
     childCtx, cancel := context.WithCancel(ctx)
-    f := &futureImpl[T]{
+    f := &futureImpl2[T1, T2]{
         ctx:        childCtx,
         cancelFunc: cancel,
         done:       make(chan struct{}),
+        opts:       applyOptions(opts),
     }
     
     go f.executeWithError(fn)
     return f
 }
 
-// New2E 创建返回(T1, T2, error)的Future
-func New2E[T1, T2 any](fn func() (T1, T2, error)) Future2[T1, T2] {
-    return New2WithContextE[T1, T2](context.Background(), fn)
+// New3E 创建返回(T1, T2, T3, error)的Future
+func New3E[T1, T2, T3 any](fn func() (T1, T2, T3, error), opts ...Option) Future3[T1, T2, T3] {
+    return New3WithContextE[T1, T2, T3](context.Background(), fn, opts...)
 }
 
-// New2WithContextE 创建带Context的(T1, T2, error) Future
-func New2WithContextE[T1, T2 any](ctx context.Context, fn func() (T1, T2, error)) Future2[T1, T2] {
+// New3WithContextE 创建带Context的(T1, T2, T3, error) Future
+func New3WithContextE[T1, T2, T3 any](ctx context.Context, fn func() (T1, T2, T3, error), opts ...Option) Future3[T1, T2, T3] {
     childCtx, cancel := context.WithCancel(ctx)
-    f := &futureImpl2[T1, T2]{
+    f := &futureImpl3[T1, T2, T3]{
         ctx:        childCtx,
         cancelFunc: cancel,
         done:       make(chan struct{}),
+        opts:       applyOptions(opts),
     }
-    
+
     go f.executeWithError(fn)
     return f
 }
@@ -164,57 +248,84 @@ func New2WithContextE[T1, T2 any](ctx context.Context, fn func() (T1, T2, error)
 // ==================== 执行方法 ====================
 
 func (f *futureImpl[T]) execute(fn func() T) {
-    defer close(f.done)
-    select {
-    case <-f.ctx.Done():
-        f.err = f.ctx.Err()
-    default:
+    defer f.finish()
+    invoke(f.hooks, f.ctx, &f.err, func() {
+        f.start()
         f.result = fn()
-    }
+    })
 }
 
 func (f *futureImpl[T]) executeWithError(fn func() (T, error)) {
-    defer close(f.done)
-    select {
-    case <-f.ctx.Done():
-        f.err = f.ctx.Err()
-    default:
+    defer f.finish()
+    invoke(f.hooks, f.ctx, &f.err, func() {
+        f.start()
         var err error
         f.result, err = fn()
         f.err = err
+    })
+}
+
+// finish 标记完成并调用已注册的回调
+func (f *futureImpl[T]) finish() {
+    f.settle(f.err)
+    untrackPending(f.track)
+    f.mu.Lock()
+    close(f.done)
+    callbacks := f.callbacks
+    f.callbacks = nil
+    f.mu.Unlock()
+
+    for _, cb := range callbacks {
+        cb()
     }
 }
 
 func (f *futureImpl2[T1, T2]) execute(fn func() (T1, T2)) {
-    defer close(f.done)
-    select {
-    case <-f.ctx.Done():
-        f.err = f.ctx.Err()
-    default:
+    defer f.finish()
+    invoke(nil, f.ctx, &f.err, func() {
+        f.start()
         f.result1, f.result2 = fn()
-    }
+    })
 }
 
 func (f *futureImpl2[T1, T2]) executeWithError(fn func() (T1, T2, error)) {
-    defer close(f.done)
-    select {
-    case <-f.ctx.Done():
-        f.err = f.ctx.Err()
-    default:
+    defer f.finish()
+    invoke(nil, f.ctx, &f.err, func() {
+        f.start()
         var err error
         f.result1, f.result2, err = fn()
         f.err = err
-    }
+    })
+}
+
+// finish 设置结束状态并关闭 done
+func (f *futureImpl2[T1, T2]) finish() {
+    f.settle(f.err)
+    close(f.done)
 }
 
 func (f *futureImpl3[T1, T2, T3]) execute(fn func() (T1, T2, T3)) {
-    defer close(f.done)
-    select {
-    case <-f.ctx.Done():
-        f.err = f.ctx.Err()
-    default:
+    defer f.finish()
+    invoke(nil, f.ctx, &f.err, func() {
+        f.start()
         f.result1, f.result2, f.result3 = fn()
-    }
+    })
+}
+
+func (f *futureImpl3[T1, T2, T3]) executeWithError(fn func() (T1, T2, T3, error)) {
+    defer f.finish()
+    invoke(nil, f.ctx, &f.err, func() {
+        f.start()
+        var err error
+        f.result1, f.result2, f.result3, err = fn()
+        f.err = err
+    })
+}
+
+// finish 设置结束状态并关闭 done
+func (f *futureImpl3[T1, T2, T3]) finish() {
+    f.settle(f.err)
+    close(f.done)
 }
 
 // ==================== 核心方法实现 ====================
@@ -225,17 +336,18 @@ func (f *futureImpl[T]) Get() T {
     return f.result
 }
 
+// GetE 等待完成并同时返回结果和错误
+func (f *futureImpl[T]) GetE() (T, error) {
+    <-f.done
+    return f.result, f.err
+}
+
 func (f *futureImpl[T]) GetWithTimeout(timeout time.Duration) (T, bool) {
-    select {
-    case <-f.done:
+    if f.opts.wait(f.done, f.ctx.Done(), timeout) {
         return f.result, true
-    case <-time.After(timeout):
-        var zero T
-        return zero, false
-    case <-f.ctx.Done():
-        var zero T
-        return zero, false
     }
+    var zero T
+    return zero, false
 }
 
 // ---- 双返回值方法 ----
@@ -244,19 +356,19 @@ func (f *futureImpl2[T1, T2]) Get() (T1, T2) {
     return f.result1, f.result2
 }
 
+// Get2E 等待完成并同时返回结果和错误
+func (f *futureImpl2[T1, T2]) Get2E() (T1, T2, error) {
+    <-f.done
+    return f.result1, f.result2, f.err
+}
+
 func (f *futureImpl2[T1, T2]) GetWithTimeout(timeout time.Duration) (T1, T2, bool) {
-    select {
-    case <-f.done:
+    if f.opts.wait(f.done, f.ctx.Done(), timeout) {
         return f.result1, f.result2, true
-    case <-time.After(timeout):
-        var zero1 T1
-        var zero2 T2
-        return zero1, zero2, false
-    case <-f.ctx.Done():
-        var zero1 T1
-        var zero2 T2
-        return zero1, zero2, false
     }
+    var zero1 T1
+    var zero2 T2
+    return zero1, zero2, false
 }
 
 // ---- 三返回值方法 ----
@@ -265,21 +377,20 @@ func (f *futureImpl3[T1, T2, T3]) Get() (T1, T2, T3) {
     return f.result1, f.result2, f.result3
 }
 
+// Get3E 等待完成并同时返回结果和错误
+func (f *futureImpl3[T1, T2, T3]) Get3E() (T1, T2, T3, error) {
+    <-f.done
+    return f.result1, f.result2, f.result3, f.err
+}
+
 func (f *futureImpl3[T1, T2, T3]) GetWithTimeout(timeout time.Duration) (T1, T2, T3, bool) {
-    select {
-    case <-f.done:
+    if f.opts.wait(f.done, f.ctx.Done(), timeout) {
         return f.result1, f.result2, f.result3, true
-    case <-time.After(timeout):
-        var zero1 T1
-        var zero2 T2
-        var zero3 T3
-        return zero1, zero2, zero3, false
-    case <-f.ctx.Done():
-        var zero1 T1
-        var zero2 T2
-        var zero3 T3
-        return zero1, zero2, zero3, false
     }
+    var zero1 T1
+    var zero2 T2
+    var zero3 T3
+    return zero1, zero2, zero3, false
 }
 
 // ==================== 通用方法 ====================
@@ -287,14 +398,7 @@ func (f *futureImpl3[T1, T2, T3]) GetWithTimeout(timeout time.Duration) (T1, T2,
 // Wait 等待完成（可带超时）
 func (f *futureImpl[T]) Wait(timeout ...time.Duration) bool {
     if len(timeout) > 0 {
-        select {
-        case <-f.done:
-            return true
-        case <-time.After(timeout[0]):
-            return false
-        case <-f.ctx.Done():
-            return false
-        }
+        return f.opts.wait(f.done, f.ctx.Done(), timeout[0])
     }
     
     <-f.done
@@ -303,14 +407,7 @@ func (f *futureImpl[T]) Wait(timeout ...time.Duration) bool {
 
 func (f *futureImpl2[T1, T2]) Wait(timeout ...time.Duration) bool {
     if len(timeout) > 0 {
-        select {
-        case <-f.done:
-            return true
-        case <-time.After(timeout[0]):
-            return false
-        case <-f.ctx.Done():
-            return false
-        }
+        return f.opts.wait(f.done, f.ctx.Done(), timeout[0])
     }
     
     <-f.done
@@ -319,14 +416,7 @@ func (f *futureImpl2[T1, T2]) Wait(timeout ...time.Duration) bool {
 
 func (f *futureImpl3[T1, T2, T3]) Wait(timeout ...time.Duration) bool {
     if len(timeout) > 0 {
-        select {
-        case <-f.done:
-            return true
-        case <-time.After(timeout[0]):
-            return false
-        case <-f.ctx.Done():
-            return false
-        }
+        return f.opts.wait(f.done, f.ctx.Done(), timeout[0])
     }
     
     <-f.done
@@ -361,6 +451,19 @@ func (f *futureImpl3[T1, T2, T3]) IsDone() bool {
     }
 }
 
+// Done 返回完成时关闭的通道，可用于 select
+func (f *futureImpl[T]) Done() <-chan struct{} {
+    return f.done
+}
+
+func (f *futureImpl2[T1, T2]) Done() <-chan struct{} {
+    return f.done
+}
+
+func (f *futureImpl3[T1, T2, T3]) Done() <-chan struct{} {
+    return f.done
+}
+
 // Cancel 取消任务
 func (f *futureImpl[T]) Cancel() {
     f.cancelFunc()
@@ -374,6 +477,15 @@ func (f *futureImpl3[T1, T2, T3]) Cancel() {
     f.cancelFunc()
 }
 
+// Cancelled 返回 f 被取消时关闭的通道，供在包外实现与 GetWithTimeout 相同的等待语义
+// f 不是由本包创建时返回 nil
+func Cancelled[T any](f Future[T]) <-chan struct{} {
+    if impl, ok := f.(*futureImpl[T]); ok {
+        return impl.ctx.Done()
+    }
+    return nil
+}
+
 // Error 获取错误信息
 func (f *futureImpl[T]) Error() error {
     <-f.done
@@ -390,6 +502,37 @@ func (f *futureImpl3[T1, T2, T3]) Error() error {
     return f.err
 }
 
+// ==================== 完成回调 ====================
+
+func (f *futureImpl[T]) OnComplete(fn func(T, error)) Future[T] {
+    f.mu.Lock()
+    select {
+    case <-f.done:
+        f.mu.Unlock()
+        fn(f.result, f.err)
+    default:
+        f.callbacks = append(f.callbacks, func() { fn(f.result, f.err) })
+        f.mu.Unlock()
+    }
+    return f
+}
+
+func (f *futureImpl[T]) OnSuccess(fn func(T)) Future[T] {
+    return f.OnComplete(func(v T, err error) {
+        if err == nil {
+            fn(v)
+        }
+    })
+}
+
+func (f *futureImpl[T]) OnError(fn func(error)) Future[T] {
+    return f.OnComplete(func(_ T, err error) {
+        if err != nil {
+            fn(err)
+        }
+    })
+}
+
 // ==================== 工具函数 ====================
 
 // Async 单返回值的快捷函数
@@ -417,6 +560,11 @@ func Async3[T1, T2, T3 any](fn func() (T1, T2, T3)) Future3[T1, T2, T3] {
     return New3(fn)
 }
 
+// Async3E (T1, T2, T3, error)的快捷函数
+func Async3E[T1, T2, T3 any](fn func() (T1, T2, T3, error)) Future3[T1, T2, T3] {
+    return New3E(fn)
+}
+
 // Then 链式调用：Future完成后执行下一个任务
 func Then[T1, T2 any](f Future[T1], fn func(T1) T2) Future[T2] {
     return New(func() T2 {
@@ -425,6 +573,121 @@ func Then[T1, T2 any](f Future[T1], fn func(T1) T2) Future[T2] {
     })
 }
 
+// ThenE 链式调用：上游出错时直接返回该错误，不再调用 fn
+func ThenE[T1, T2 any](f Future[T1], fn func(T1) (T2, error)) Future[T2] {
+    return NewE(func() (T2, error) {
+        result := f.Get()
+        if err := f.Error(); err != nil {
+            var zero T2
+            return zero, err
+        }
+        return fn(result)
+    })
+}
+
+// FlatMap 链式调用：fn 返回新的Future，结果直接展开为该Future的结果
+// 上游或 fn 返回的Future出错时传递该错误；取消结果Future会同时取消正在等待的Future
+func FlatMap[T, U any](f Future[T], fn func(T) Future[U]) Future[U] {
+    out := newImpl[U](context.Background())
+    ctx := out.ctx
+    go out.executeWithError(func() (U, error) {
+        var zero U
+        if err := await(ctx, f); err != nil {
+            return zero, err
+        }
+        if err := f.Error(); err != nil {
+            return zero, err
+        }
+        inner := fn(f.Get())
+        if err := await(ctx, inner); err != nil {
+            return zero, err
+        }
+        return inner.Get(), inner.Error()
+    })
+    return out
+}
+
+// newImpl 创建尚未启动的单返回值实现，由调用方负责启动 execute
+func newImpl[T any](ctx context.Context, opts ...Option) *futureImpl[T] {
+    childCtx, cancel := context.WithCancel(ctx)
+    f := &futureImpl[T]{
+        ctx:        childCtx,
+        cancelFunc: cancel,
+        done:       make(chan struct{}),
+        opts:       applyOptions(opts),
+    }
+    f.track = trackPending(f.opts.name, f.State)
+    return f
+}
+
+// waitable 可等待、可取消的Future
+type waitable interface {
+    Done() <-chan struct{}
+    Cancel()
+}
+
+// await 等待 w 完成；ctx 先结束时取消 w 并返回 ctx.Err()
+func await(ctx context.Context, w waitable) error {
+    select {
+    case <-w.Done():
+        return nil
+    case <-ctx.Done():
+        w.Cancel()
+        return ctx.Err()
+    }
+}
+
+// ThenLinked 与 Then 相同，但上下游的取消相互关联：
+// 取消结果Future会取消 f；取消 f 会使结果Future以 context.Canceled 失败；f 出错时直接传递该错误
+func ThenLinked[T1, T2 any](f Future[T1], fn func(T1) T2) Future[T2] {
+    parent := context.Background()
+    if c, ok := f.(interface{ context() context.Context }); ok {
+        parent = c.context()
+    }
+    out := newImpl[T2](parent)
+    // 任务开始前被取消时 execute 不会运行 fn，因此单独注册取消上游
+    stop := context.AfterFunc(out.ctx, f.Cancel)
+    out.OnComplete(func(T2, error) { stop() })
+    go out.executeWithError(func() (T2, error) {
+        var zero T2
+        if err := await(out.ctx, f); err != nil {
+            return zero, err
+        }
+        if err := f.Error(); err != nil {
+            return zero, err
+        }
+        return fn(f.Get()), nil
+    })
+    return out
+}
+
+// context 返回Future自身的 ctx，供 ThenLinked 建立取消关联
+func (f *futureImpl[T]) context() context.Context {
+    return f.ctx
+}
+
+// Fallback primary 失败时以其错误调用 fallback，并使用 fallback 返回的Future的结果
+// 取消结果Future会取消正在等待的Future
+func Fallback[T any](primary Future[T], fallback func(error) Future[T]) Future[T] {
+    out := newImpl[T](context.Background())
+    go out.executeWithError(func() (T, error) {
+        var zero T
+        if err := await(out.ctx, primary); err != nil {
+            return zero, err
+        }
+        v, err := primary.GetE()
+        if err == nil {
+            return v, nil
+        }
+        alt := fallback(err)
+        if err := await(out.ctx, alt); err != nil {
+            return zero, err
+        }
+        return alt.GetE()
+    })
+    return out
+}
+
 // Then2 双返回值的链式调用
 func Then2[T1, T2, R any](f Future2[T1, T2], fn func(T1, T2) R) Future[R] {
     return New(func() R {
@@ -444,10 +707,96 @@ func All[T any](futures ...Future[T]) Future[[]T] {
     })
 }
 
+// AllE 等待所有Future完成，任一出错时立即返回该错误并取消其余Future
+// 取消结果Future同样会取消所有输入Future
+func AllE[T any](futures ...Future[T]) Future[[]T] {
+    out := newImpl[[]T](context.Background())
+    go out.executeWithError(func() ([]T, error) {
+        cancelAll := func() {
+            for _, f := range futures {
+                f.Cancel()
+            }
+        }
+
+        errs := make(chan error, len(futures))
+        for _, f := range futures {
+            go func(future Future[T]) {
+                future.Wait()
+                errs <- future.Error()
+            }(f)
+        }
+        for range futures {
+            select {
+            case err := <-errs:
+                if err != nil {
+                    cancelAll()
+                    return nil, err
+                }
+            case <-out.ctx.Done():
+                cancelAll()
+                return nil, out.ctx.Err()
+            }
+        }
+
+        results := make([]T, len(futures))
+        for i, f := range futures {
+            results[i] = f.Get()
+        }
+        return results, nil
+    })
+    return out
+}
+
+// AllSettled 等待所有Future完成，按输入顺序返回每个Future的结果或错误
+func AllSettled[T any](futures ...Future[T]) Future[[]option.Result[T, error]] {
+    return New(func() []option.Result[T, error] {
+        results := make([]option.Result[T, error], len(futures))
+        for i, f := range futures {
+            v := f.Get()
+            if err := f.Error(); err != nil {
+                results[i] = option.Err[T](err)
+            } else {
+                results[i] = option.Ok[T, error](v)
+            }
+        }
+        return results
+    })
+}
+
+// WaitAllTimeout 最多等待 d，按输入顺序返回结果：期间成功完成的为 Some，未完成或失败的为 None
+// allDone 表示所有Future都在期限内完成（包括失败）；未完成的Future不会被取消
+// 期限由 WithClock 指定的时钟计时
+func WaitAllTimeout[T any](d time.Duration, futures []Future[T], opts ...Option) (results []option.Option[T], allDone bool) {
+    expired, stop := applyOptions(opts).clock.NewTimer(d)
+    defer stop()
+
+    results = make([]option.Option[T], len(futures))
+    allDone = true
+    for i, f := range futures {
+        if allDone {
+            select {
+            case <-f.Done():
+            case <-expired:
+                allDone = false
+            }
+        }
+        results[i] = option.None[T]()
+        if f.IsDone() {
+            if v, err := f.GetE(); err == nil {
+                results[i] = option.Some(v)
+            }
+        } else {
+            allDone = false
+        }
+    }
+    return results, allDone
+}
+
 // Any 等待任意一个Future完成（单返回值）
 func Any[T any](futures ...Future[T]) Future[T] {
     return New(func() T {
-        done := make(chan T, 1)
+        // 缓冲区足够容纳所有结果，落后的Future不会阻塞
+        done := make(chan T, len(futures))
         
         for _, f := range futures {
             go func(future Future[T]) {
@@ -459,6 +808,141 @@ func Any[T any](futures ...Future[T]) Future[T] {
     })
 }
 
+// AnyOk 返回第一个成功完成的Future的结果，并取消其余Future
+// 所有Future都失败时，错误为所有错误的 errors.Join
+func AnyOk[T any](futures ...Future[T]) Future[T] {
+    out := newImpl[T](context.Background())
+    go out.executeWithError(func() (T, error) {
+        var zero T
+        if len(futures) == 0 {
+            return zero, ErrNoFutures
+        }
+
+        done := make(chan int, len(futures))
+        for i, f := range futures {
+            go func(i int, future Future[T]) {
+                future.Wait()
+                done <- i
+            }(i, f)
+        }
+
+        errs := make([]error, 0, len(futures))
+        for range futures {
+            select {
+            case i := <-done:
+                if err := futures[i].Error(); err != nil {
+                    errs = append(errs, err)
+                    continue
+                }
+                for j, other := range futures {
+                    if j != i {
+                        other.Cancel()
+                    }
+                }
+                return futures[i].Get(), nil
+            case <-out.ctx.Done():
+                for _, f := range futures {
+                    f.Cancel()
+                }
+                return zero, out.ctx.Err()
+            }
+        }
+        return zero, errors.Join(errs...)
+    })
+    return out
+}
+
+// RaceResult Race 的结果，Index 为最先完成的Future在参数中的下标
+type RaceResult[T any] struct {
+    Index int
+    Value T
+    Err   error
+}
+
+// Race 返回最先完成的Future（无论成功与否），并取消其余Future
+// 没有输入时以 ErrNoFutures 失败
+func Race[T any](futures ...Future[T]) Future[RaceResult[T]] {
+    out := newImpl[RaceResult[T]](context.Background())
+    go out.executeWithError(func() (RaceResult[T], error) {
+        if len(futures) == 0 {
+            return RaceResult[T]{Index: -1}, ErrNoFutures
+        }
+
+        done := make(chan int, len(futures))
+        for i, f := range futures {
+            go func(i int, future Future[T]) {
+                future.Wait()
+                done <- i
+            }(i, f)
+        }
+
+        select {
+        case i := <-done:
+            for j, other := range futures {
+                if j != i {
+                    other.Cancel()
+                }
+            }
+            return RaceResult[T]{Index: i, Value: futures[i].Get(), Err: futures[i].Error()}, nil
+        case <-out.ctx.Done():
+            for _, f := range futures {
+                f.Cancel()
+            }
+            return RaceResult[T]{Index: -1}, out.ctx.Err()
+        }
+    })
+    return out
+}
+
+// WithTimeout 返回在 d 内跟随 f 结果的Future；超时则以 ErrTimeout 失败并取消 f
+func WithTimeout[T any](f Future[T], d time.Duration, opts ...Option) Future[T] {
+    out := newImpl[T](context.Background(), opts...)
+    go out.executeWithError(func() (T, error) {
+        expired, stop := out.opts.clock.NewTimer(d)
+        defer stop()
+        select {
+        case <-f.Done():
+            return f.GetE()
+        case <-expired:
+            f.Cancel()
+            var zero T
+            return zero, ErrTimeout
+        case <-out.ctx.Done():
+            f.Cancel()
+            var zero T
+            return zero, out.ctx.Err()
+        }
+    })
+    return out
+}
+
+// IndexedResult 带下标的结果，Index 为Future在参数中的下标
+type IndexedResult[T any] struct {
+    Index int
+    Value T
+    Err   error
+}
+
+// AsCompleted 按完成顺序发送每个Future的结果，全部发送后关闭通道
+// 通道有足够的缓冲，不读取也不会泄漏 goroutine
+func AsCompleted[T any](futures ...Future[T]) <-chan IndexedResult[T] {
+    out := make(chan IndexedResult[T], len(futures))
+    var wg sync.WaitGroup
+    for i, f := range futures {
+        wg.Add(1)
+        go func(i int, future Future[T]) {
+            defer wg.Done()
+            v, err := future.GetE()
+            out <- IndexedResult[T]{Index: i, Value: v, Err: err}
+        }(i, f)
+    }
+    go func() {
+        wg.Wait()
+        close(out)
+    }()
+    return out
+}
+
 // Map 对Future结果进行转换
 func Map[T, R any](f Future[T], fn func(T) R) Future[R] {
     return New(func() R {
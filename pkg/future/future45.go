@@ -0,0 +1,308 @@
+package future
+
+import (
+    "context"
+    "time"
+)
+
+// ==================== Future4 ====================
+
+// Future4 四返回值Future接口
+type Future4[T1, T2, T3, T4 any] interface {
+    Get() (T1, T2, T3, T4)
+    Get4E() (T1, T2, T3, T4, error)
+    GetWithTimeout(timeout time.Duration) (T1, T2, T3, T4, bool)
+    Wait(timeout ...time.Duration) bool
+    IsDone() bool
+    Done() <-chan struct{}
+    Cancel()
+    Error() error
+    State() State
+}
+
+// futureImpl4 四返回值实现
+type futureImpl4[T1, T2, T3, T4 any] struct {
+    ctx        context.Context
+    cancelFunc context.CancelFunc
+    result1    T1
+    result2    T2
+    result3    T3
+    result4    T4
+    done       chan struct{}
+    err        error
+    opts       options
+    lifecycle
+}
+
+// New4 创建四返回值Future
+func New4[T1, T2, T3, T4 any](fn func() (T1, T2, T3, T4), opts ...Option) Future4[T1, T2, T3, T4] {
+    return New4WithContext[T1, T2, T3, T4](context.Background(), fn, opts...)
+}
+
+// New4WithContext 创建带Context的四返回值Future
+func New4WithContext[T1, T2, T3, T4 any](ctx context.Context, fn func() (T1, T2, T3, T4), opts ...Option) Future4[T1, T2, T3, T4] {
+    f := new4[T1, T2, T3, T4](ctx, opts)
+    go f.execute(fn)
+    return f
+}
+
+// New4E 创建返回(T1, T2, T3, T4, error)的Future
+func New4E[T1, T2, T3, T4 any](fn func() (T1, T2, T3, T4, error), opts ...Option) Future4[T1, T2, T3, T4] {
+    return New4WithContextE[T1, T2, T3, T4](context.Background(), fn, opts...)
+}
+
+// New4WithContextE 创建带Context的(T1, T2, T3, T4, error) Future
+func New4WithContextE[T1, T2, T3, T4 any](ctx context.Context, fn func() (T1, T2, T3, T4, error), opts ...Option) Future4[T1, T2, T3, T4] {
+    f := new4[T1, T2, T3, T4](ctx, opts)
+    go f.executeWithError(fn)
+    return f
+}
+
+// Async4 四返回值的快捷函数
+func Async4[T1, T2, T3, T4 any](fn func() (T1, T2, T3, T4)) Future4[T1, T2, T3, T4] {
+    return New4(fn)
+}
+
+// Async4E (T1, T2, T3, T4, error)的快捷函数
+func Async4E[T1, T2, T3, T4 any](fn func() (T1, T2, T3, T4, error)) Future4[T1, T2, T3, T4] {
+    return New4E(fn)
+}
+
+func new4[T1, T2, T3, T4 any](ctx context.Context, opts []Option) *futureImpl4[T1, T2, T3, T4] {
+    childCtx, cancel := context.WithCancel(ctx)
+    return &futureImpl4[T1, T2, T3, T4]{
+        ctx:        childCtx,
+        cancelFunc: cancel,
+        done:       make(chan struct{}),
+        opts:       applyOptions(opts),
+    }
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) execute(fn func() (T1, T2, T3, T4)) {
+    defer f.finish()
+    invoke(nil, f.ctx, &f.err, func() {
+        f.start()
+        f.result1, f.result2, f.result3, f.result4 = fn()
+    })
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) executeWithError(fn func() (T1, T2, T3, T4, error)) {
+    defer f.finish()
+    invoke(nil, f.ctx, &f.err, func() {
+        f.start()
+        var err error
+        f.result1, f.result2, f.result3, f.result4, err = fn()
+        f.err = err
+    })
+}
+
+// finish 设置结束状态并关闭 done
+func (f *futureImpl4[T1, T2, T3, T4]) finish() {
+    f.settle(f.err)
+    close(f.done)
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) Get() (T1, T2, T3, T4) {
+    <-f.done
+    return f.result1, f.result2, f.result3, f.result4
+}
+
+// Get4E 等待完成并同时返回结果和错误
+func (f *futureImpl4[T1, T2, T3, T4]) Get4E() (T1, T2, T3, T4, error) {
+    <-f.done
+    return f.result1, f.result2, f.result3, f.result4, f.err
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) GetWithTimeout(timeout time.Duration) (T1, T2, T3, T4, bool) {
+    if f.opts.wait(f.done, f.ctx.Done(), timeout) {
+        return f.result1, f.result2, f.result3, f.result4, true
+    }
+    var zero1 T1
+    var zero2 T2
+    var zero3 T3
+    var zero4 T4
+    return zero1, zero2, zero3, zero4, false
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) Wait(timeout ...time.Duration) bool {
+    if len(timeout) > 0 {
+        return f.opts.wait(f.done, f.ctx.Done(), timeout[0])
+    }
+
+    <-f.done
+    return true
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) IsDone() bool {
+    select {
+    case <-f.done:
+        return true
+    default:
+        return false
+    }
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) Done() <-chan struct{} {
+    return f.done
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) Cancel() {
+    f.cancelFunc()
+}
+
+func (f *futureImpl4[T1, T2, T3, T4]) Error() error {
+    <-f.done
+    return f.err
+}
+
+// ==================== Future5 ====================
+
+// Future5 五返回值Future接口
+type Future5[T1, T2, T3, T4, T5 any] interface {
+    Get() (T1, T2, T3, T4, T5)
+    Get5E() (T1, T2, T3, T4, T5, error)
+    GetWithTimeout(timeout time.Duration) (T1, T2, T3, T4, T5, bool)
+    Wait(timeout ...time.Duration) bool
+    IsDone() bool
+    Done() <-chan struct{}
+    Cancel()
+    Error() error
+    State() State
+}
+
+// futureImpl5 五返回值实现
+type futureImpl5[T1, T2, T3, T4, T5 any] struct {
+    ctx        context.Context
+    cancelFunc context.CancelFunc
+    result1    T1
+    result2    T2
+    result3    T3
+    result4    T4
+    result5    T5
+    done       chan struct{}
+    err        error
+    opts       options
+    lifecycle
+}
+
+// New5 创建五返回值Future
+func New5[T1, T2, T3, T4, T5 any](fn func() (T1, T2, T3, T4, T5), opts ...Option) Future5[T1, T2, T3, T4, T5] {
+    return New5WithContext[T1, T2, T3, T4, T5](context.Background(), fn, opts...)
+}
+
+// New5WithContext 创建带Context的五返回值Future
+func New5WithContext[T1, T2, T3, T4, T5 any](ctx context.Context, fn func() (T1, T2, T3, T4, T5), opts ...Option) Future5[T1, T2, T3, T4, T5] {
+    f := new5[T1, T2, T3, T4, T5](ctx, opts)
+    go f.execute(fn)
+    return f
+}
+
+// New5E 创建返回(T1, T2, T3, T4, T5, error)的Future
+func New5E[T1, T2, T3, T4, T5 any](fn func() (T1, T2, T3, T4, T5, error), opts ...Option) Future5[T1, T2, T3, T4, T5] {
+    return New5WithContextE[T1, T2, T3, T4, T5](context.Background(), fn, opts...)
+}
+
+// New5WithContextE 创建带Context的(T1, T2, T3, T4, T5, error) Future
+func New5WithContextE[T1, T2, T3, T4, T5 any](ctx context.Context, fn func() (T1, T2, T3, T4, T5, error), opts ...Option) Future5[T1, T2, T3, T4, T5] {
+    f := new5[T1, T2, T3, T4, T5](ctx, opts)
+    go f.executeWithError(fn)
+    return f
+}
+
+// Async5 五返回值的快捷函数
+func Async5[T1, T2, T3, T4, T5 any](fn func() (T1, T2, T3, T4, T5)) Future5[T1, T2, T3, T4, T5] {
+    return New5(fn)
+}
+
+// Async5E (T1, T2, T3, T4, T5, error)的快捷函数
+func Async5E[T1, T2, T3, T4, T5 any](fn func() (T1, T2, T3, T4, T5, error)) Future5[T1, T2, T3, T4, T5] {
+    return New5E(fn)
+}
+
+func new5[T1, T2, T3, T4, T5 any](ctx context.Context, opts []Option) *futureImpl5[T1, T2, T3, T4, T5] {
+    childCtx, cancel := context.WithCancel(ctx)
+    return &futureImpl5[T1, T2, T3, T4, T5]{
+        ctx:        childCtx,
+        cancelFunc: cancel,
+        done:       make(chan struct{}),
+        opts:       applyOptions(opts),
+    }
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) execute(fn func() (T1, T2, T3, T4, T5)) {
+    defer f.finish()
+    invoke(nil, f.ctx, &f.err, func() {
+        f.start()
+        f.result1, f.result2, f.result3, f.result4, f.result5 = fn()
+    })
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) executeWithError(fn func() (T1, T2, T3, T4, T5, error)) {
+    defer f.finish()
+    invoke(nil, f.ctx, &f.err, func() {
+        f.start()
+        var err error
+        f.result1, f.result2, f.result3, f.result4, f.result5, err = fn()
+        f.err = err
+    })
+}
+
+// finish 设置结束状态并关闭 done
+func (f *futureImpl5[T1, T2, T3, T4, T5]) finish() {
+    f.settle(f.err)
+    close(f.done)
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) Get() (T1, T2, T3, T4, T5) {
+    <-f.done
+    return f.result1, f.result2, f.result3, f.result4, f.result5
+}
+
+// Get5E 等待完成并同时返回结果和错误
+func (f *futureImpl5[T1, T2, T3, T4, T5]) Get5E() (T1, T2, T3, T4, T5, error) {
+    <-f.done
+    return f.result1, f.result2, f.result3, f.result4, f.result5, f.err
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) GetWithTimeout(timeout time.Duration) (T1, T2, T3, T4, T5, bool) {
+    if f.opts.wait(f.done, f.ctx.Done(), timeout) {
+        return f.result1, f.result2, f.result3, f.result4, f.result5, true
+    }
+    var zero1 T1
+    var zero2 T2
+    var zero3 T3
+    var zero4 T4
+    var zero5 T5
+    return zero1, zero2, zero3, zero4, zero5, false
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) Wait(timeout ...time.Duration) bool {
+    if len(timeout) > 0 {
+        return f.opts.wait(f.done, f.ctx.Done(), timeout[0])
+    }
+
+    <-f.done
+    return true
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) IsDone() bool {
+    select {
+    case <-f.done:
+        return true
+    default:
+        return false
+    }
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) Done() <-chan struct{} {
+    return f.done
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) Cancel() {
+    f.cancelFunc()
+}
+
+func (f *futureImpl5[T1, T2, T3, T4, T5]) Error() error {
+    <-f.done
+    return f.err
+}
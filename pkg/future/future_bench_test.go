@@ -0,0 +1,53 @@
+package future
+
+import (
+    "testing"
+    "time"
+)
+
+// pendingFuture 返回一个在 b 结束前不会完成的 Future
+func pendingFuture(b *testing.B) Future[int] {
+    release := make(chan struct{})
+    b.Cleanup(func() { close(release) })
+    return New(func() int {
+        <-release
+        return 0
+    })
+}
+
+// BenchmarkGetWithTimeout_Done 已完成时走快速路径，不创建定时器
+func BenchmarkGetWithTimeout_Done(b *testing.B) {
+    f := New(func() int { return 1 })
+    f.Wait()
+
+    b.ReportAllocs()
+    for b.Loop() {
+        if _, ok := f.GetWithTimeout(time.Second); !ok {
+            b.Fatal("GetWithTimeout on done future timed out")
+        }
+    }
+}
+
+// BenchmarkGetWithTimeout_Pending 未完成时创建可停止的定时器并等待超时
+func BenchmarkGetWithTimeout_Pending(b *testing.B) {
+    f := pendingFuture(b)
+
+    b.ReportAllocs()
+    for b.Loop() {
+        if _, ok := f.GetWithTimeout(time.Microsecond); ok {
+            b.Fatal("GetWithTimeout on pending future succeeded")
+        }
+    }
+}
+
+// BenchmarkWaitTimeout 未完成时 Wait 的超时路径
+func BenchmarkWaitTimeout(b *testing.B) {
+    f := pendingFuture(b)
+
+    b.ReportAllocs()
+    for b.Loop() {
+        if f.Wait(time.Microsecond) {
+            b.Fatal("Wait on pending future succeeded")
+        }
+    }
+}
@@ -0,0 +1,84 @@
+// Package futuretest 提供测试基于 future 包的代码所需的工具：
+// 同步执行器、虚拟时钟和断言函数，避免真实等待和轮询。
+//
+//	f := future.NewOn(futuretest.NewExecutor(), load) // NewOn 返回时已执行完
+//	v := futuretest.AssertCompletesWithin(t, f, time.Second)
+//
+//	clk := futuretest.NewClock()
+//	g := future.New(slow, future.WithClock(clk))
+//	go func() {
+//		clk.BlockUntil(1)
+//		clk.Advance(time.Second)
+//	}()
+//	_, ok := g.GetWithTimeout(time.Second) // ok == false，无需真实等待
+package futuretest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+	"github.com/hunter-hongg/GoPlus/pkg/future/fakeclock"
+)
+
+// ==================== 执行器与时钟 ====================
+
+// NewExecutor 创建同步执行器：任务在提交者的 goroutine 中执行，提交返回时Future已完成
+func NewExecutor(opts ...future.ExecutorOption) *future.Executor {
+	return future.NewExecutor(1, append(opts, future.WithSynchronous())...)
+}
+
+// Clock 手动推进的虚拟时钟，通过 future.WithClock 传给Future
+type Clock = fakeclock.Clock
+
+// NewClock 创建起始于当前时间的虚拟时钟
+func NewClock() *Clock {
+	return fakeclock.New(time.Now())
+}
+
+// ==================== 断言 ====================
+
+// AssertCompletesWithin 断言 f 在 d 内成功完成并返回其结果
+// f 提前完成时立即返回；超时或 f 出错时测试失败
+func AssertCompletesWithin[T any](t testing.TB, f future.Future[T], d time.Duration) T {
+	t.Helper()
+	if !waitDone(f.Done(), d) {
+		t.Fatalf("future did not complete within %v (state %v)", d, f.State())
+	}
+	v, err := f.GetE()
+	if err != nil {
+		t.Fatalf("future completed with error: %v", err)
+	}
+	return v
+}
+
+// AssertCancelled 断言 f 在 d 内以取消或超时结束
+func AssertCancelled[T any](t testing.TB, f future.Future[T], d time.Duration) {
+	t.Helper()
+	if !waitDone(f.Done(), d) {
+		t.Fatalf("future did not finish within %v (state %v)", d, f.State())
+	}
+	if s := f.State(); s != future.StateCancelled {
+		t.Fatalf("future state = %v, want %v (error %v)", s, future.StateCancelled, f.Error())
+	}
+}
+
+// AssertPending 断言 f 尚未完成
+func AssertPending[T any](t testing.TB, f future.Future[T]) {
+	t.Helper()
+	if s := f.State(); s.Terminal() {
+		t.Fatalf("future already finished (state %v, error %v)", s, f.Error())
+	}
+}
+
+// waitDone 等待 done 关闭，最多等待 d
+func waitDone(done <-chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
@@ -0,0 +1,216 @@
+package future
+
+import (
+    "context"
+    "errors"
+    "sync"
+
+    "github.com/hunter-hongg/GoPlus/pkg/errorsx"
+)
+
+// Group 带类型结果的 errgroup：收集每个任务的结果，第一个错误会取消组的 ctx
+// 零值可直接使用（没有关联的 ctx）
+type Group[T any] struct {
+    cancel context.CancelCauseFunc
+    wg     sync.WaitGroup
+    sem    chan struct{}
+
+    mu      sync.Mutex
+    results []T
+    err     error
+}
+
+// NewGroup 创建 Group 以及派生的 ctx，任一任务出错或 Wait 返回时取消该 ctx
+func NewGroup[T any](ctx context.Context) (*Group[T], context.Context) {
+    ctx, cancel := context.WithCancelCause(ctx)
+    return &Group[T]{cancel: cancel}, ctx
+}
+
+// SetLimit 限制同时运行的任务数，n<0 表示不限制；必须在调用 Go 之前设置
+func (g *Group[T]) SetLimit(n int) {
+    if n < 0 {
+        g.sem = nil
+        return
+    }
+    g.sem = make(chan struct{}, n)
+}
+
+// Go 启动任务，达到并发上限时阻塞直到有任务结束
+// 结果按调用 Go 的顺序出现在 Wait 返回的切片中
+func (g *Group[T]) Go(fn func() (T, error)) {
+    if g.sem != nil {
+        g.sem <- struct{}{}
+    }
+
+    g.mu.Lock()
+    idx := len(g.results)
+    var zero T
+    g.results = append(g.results, zero)
+    g.mu.Unlock()
+
+    g.wg.Add(1)
+    go func() {
+        defer g.wg.Done()
+        if g.sem != nil {
+            defer func() { <-g.sem }()
+        }
+
+        var (
+            v   T
+            err error
+        )
+        func() {
+            defer errorsx.Recover(&err)
+            v, err = fn()
+        }()
+
+        g.mu.Lock()
+        defer g.mu.Unlock()
+        g.results[idx] = v
+        if err != nil && g.err == nil {
+            g.err = err
+            if g.cancel != nil {
+                g.cancel(err)
+            }
+        }
+    }()
+}
+
+// Wait 等待所有任务结束，返回全部结果和第一个错误
+func (g *Group[T]) Wait() ([]T, error) {
+    g.wg.Wait()
+    if g.cancel != nil {
+        g.cancel(g.err)
+    }
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    return g.results, g.err
+}
+
+// Future 在后台等待所有任务结束，出错时Future以第一个错误失败
+func (g *Group[T]) Future() Future[[]T] {
+    return NewE(g.Wait)
+}
+
+// failed 判断是否已有任务出错
+func (g *Group[T]) failed() bool {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    return g.err != nil
+}
+
+// ==================== 并行映射 ====================
+
+// ParallelMap 以最多 concurrency 个并发（<=0 表示不限制）对 items 应用 fn，结果保持输入顺序
+// 任一调用出错后不再启动新的调用，结果Future以第一个错误失败；取消结果Future同样会停止启动新的调用
+func ParallelMap[T, R any](items []T, concurrency int, fn func(T) (R, error)) Future[[]R] {
+    out := newImpl[[]R](context.Background())
+    go out.executeWithError(func() ([]R, error) {
+        var g Group[R]
+        if concurrency > 0 {
+            g.SetLimit(concurrency)
+        }
+        for _, item := range items {
+            if g.failed() || out.ctx.Err() != nil {
+                break
+            }
+            g.Go(func() (R, error) { return fn(item) })
+        }
+
+        results, err := g.Wait()
+        if err == nil {
+            err = out.ctx.Err()
+        }
+        if err != nil {
+            return nil, err
+        }
+        return results, nil
+    })
+    return out
+}
+
+// forEachConfig ParallelForEach 配置
+type forEachConfig struct {
+    stopOnError bool
+}
+
+// ForEachOption ParallelForEach 配置选项
+type ForEachOption func(*forEachConfig)
+
+// StopOnError 第一个错误出现后取消 ctx、不再启动新的调用，并只返回该错误
+func StopOnError() ForEachOption {
+    return func(c *forEachConfig) { c.stopOnError = true }
+}
+
+// ParallelForEach 以最多 limit 个并发（<=0 表示不限制）对 items 调用 fn，返回所有错误的 errors.Join
+// ctx 结束后不再启动新的调用，并在结果中包含 ctx.Err()
+func ParallelForEach[T any](ctx context.Context, items []T, limit int, fn func(context.Context, T) error, opts ...ForEachOption) error {
+    var cfg forEachConfig
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    parent := ctx
+    ctx, cancel := context.WithCancel(ctx)
+    defer cancel()
+
+    var (
+        wg   sync.WaitGroup
+        mu   sync.Mutex
+        errs []error
+        sem  chan struct{}
+    )
+    if limit > 0 {
+        sem = make(chan struct{}, limit)
+    }
+
+    fail := func(err error) {
+        mu.Lock()
+        defer mu.Unlock()
+        if cfg.stopOnError && len(errs) > 0 {
+            return
+        }
+        errs = append(errs, err)
+        if cfg.stopOnError {
+            cancel()
+        }
+    }
+
+loop:
+    for _, item := range items {
+        if sem != nil {
+            select {
+            case sem <- struct{}{}:
+            case <-ctx.Done():
+                break loop
+            }
+        } else if ctx.Err() != nil {
+            break
+        }
+
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            if sem != nil {
+                defer func() { <-sem }()
+            }
+            var err error
+            func() {
+                defer errorsx.Recover(&err)
+                err = fn(ctx, item)
+            }()
+            if err != nil {
+                fail(err)
+            }
+        }()
+    }
+    wg.Wait()
+
+    if cfg.stopOnError && len(errs) > 0 {
+        return errs[0]
+    }
+    if err := parent.Err(); err != nil {
+        errs = append(errs, err)
+    }
+    return errors.Join(errs...)
+}
@@ -0,0 +1,79 @@
+package future
+
+import (
+    "context"
+    "sync/atomic"
+    "time"
+
+    "github.com/hunter-hongg/GoPlus/pkg/errorsx"
+    "github.com/hunter-hongg/GoPlus/pkg/metrics"
+)
+
+// Hooks 任务执行的观测钩子，未设置的字段会被忽略
+// 钩子在执行任务的 goroutine 中同步调用，应当尽快返回
+type Hooks struct {
+    // OnStart 任务开始运行
+    OnStart func()
+    // OnComplete 任务运行结束，err 包含 panic 转换的错误
+    OnComplete func(elapsed time.Duration, err error)
+    // OnCancel 任务在开始前已被取消，不会运行
+    OnCancel func(err error)
+}
+
+var globalHooks atomic.Pointer[Hooks]
+
+// SetHooks 设置全局钩子，对之后运行的所有任务生效（执行器通过 WithHooks 设置的除外）
+func SetHooks(h Hooks) {
+    globalHooks.Store(&h)
+}
+
+// MetricsHooks 返回把任务上报到 metrics 全局提供者的钩子，name 作为 name 标签
+// 指标：future_started_total、future_completed_total{result}、future_cancelled_total、future_duration_seconds
+func MetricsHooks(name string) Hooks {
+    label := metrics.L("name", name)
+    return Hooks{
+        OnStart: func() {
+            metrics.Default().Counter("future_started_total", label).Inc()
+        },
+        OnComplete: func(elapsed time.Duration, err error) {
+            result := "ok"
+            if err != nil {
+                result = "error"
+            }
+            p := metrics.Default()
+            p.Counter("future_completed_total", label, metrics.L("result", result)).Inc()
+            p.Histogram("future_duration_seconds", label).Observe(elapsed.Seconds())
+        },
+        OnCancel: func(error) {
+            metrics.Default().Counter("future_cancelled_total", label).Inc()
+        },
+    }
+}
+
+// invoke 在 ctx 未结束时运行 fn，捕获 panic 写入 errp，并调用钩子
+// h 为 nil 时使用全局钩子
+func invoke(h *Hooks, ctx context.Context, errp *error, fn func()) {
+    if h == nil {
+        h = globalHooks.Load()
+    }
+
+    select {
+    case <-ctx.Done():
+        *errp = ctx.Err()
+        if h != nil && h.OnCancel != nil {
+            h.OnCancel(*errp)
+        }
+        return
+    default:
+    }
+
+    if h != nil && h.OnStart != nil {
+        h.OnStart()
+    }
+    if h != nil && h.OnComplete != nil {
+        start := time.Now()
+        defer func() { h.OnComplete(time.Since(start), *errp) }()
+    }
+    defer errorsx.Recover(errp)
+    fn()
+}
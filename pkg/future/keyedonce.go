@@ -0,0 +1,61 @@
+package future
+
+import (
+    "sync"
+    "time"
+)
+
+// KeyedOnce 按键去重的并发调用：同一个键的并发 Do 共享同一个进行中的Future
+// 零值可直接使用，Future 完成后立即遗忘该键，SetHold 可让结果再保留一段时间
+type KeyedOnce[K comparable, T any] struct {
+    mu       sync.Mutex
+    inflight map[K]Future[T]
+    hold     time.Duration
+}
+
+// NewKeyedOnce 创建 KeyedOnce，完成的Future再保留 hold 时长（<=0 表示完成后立即遗忘）
+func NewKeyedOnce[K comparable, T any](hold time.Duration) *KeyedOnce[K, T] {
+    return &KeyedOnce[K, T]{hold: hold}
+}
+
+// Do 返回 key 对应的进行中的Future，不存在时以 fn 启动新的Future
+func (o *KeyedOnce[K, T]) Do(key K, fn func() (T, error)) Future[T] {
+    o.mu.Lock()
+    if f, ok := o.inflight[key]; ok {
+        o.mu.Unlock()
+        return f
+    }
+    if o.inflight == nil {
+        o.inflight = make(map[K]Future[T])
+    }
+    f := NewE(fn)
+    o.inflight[key] = f
+    hold := o.hold
+    o.mu.Unlock()
+
+    // 已完成时回调会立即执行，因此必须在释放锁之后注册
+    f.OnComplete(func(T, error) {
+        if hold > 0 {
+            time.AfterFunc(hold, func() { o.forget(key, f) })
+            return
+        }
+        o.forget(key, f)
+    })
+    return f
+}
+
+// Forget 立即遗忘 key，之后的 Do 会重新执行
+func (o *KeyedOnce[K, T]) Forget(key K) {
+    o.mu.Lock()
+    defer o.mu.Unlock()
+    delete(o.inflight, key)
+}
+
+// forget 仅在 key 仍对应 f 时删除，避免误删 Forget 之后新启动的Future
+func (o *KeyedOnce[K, T]) forget(key K, f Future[T]) {
+    o.mu.Lock()
+    defer o.mu.Unlock()
+    if o.inflight[key] == f {
+        delete(o.inflight, key)
+    }
+}
@@ -0,0 +1,51 @@
+package future
+
+import (
+    "sync"
+    "time"
+)
+
+// memoEntry 缓存的Future，expires 在成功完成后设置
+type memoEntry[T any] struct {
+    f       Future[T]
+    expires time.Time
+}
+
+// Memoize 返回按键缓存Future的函数：同一个键的并发调用共享同一个进行中的Future，
+// 成功结果缓存 ttl 时长（<=0 表示永不过期），失败结果不缓存，下一次调用会重新执行 fn
+func Memoize[K comparable, T any](ttl time.Duration, fn func(K) (T, error)) func(K) Future[T] {
+    var (
+        mu      sync.Mutex
+        entries = make(map[K]*memoEntry[T])
+    )
+    return func(key K) Future[T] {
+        mu.Lock()
+        if e, ok := entries[key]; ok {
+            if e.expires.IsZero() || time.Now().Before(e.expires) {
+                mu.Unlock()
+                return e.f
+            }
+            delete(entries, key)
+        }
+
+        e := &memoEntry[T]{}
+        e.f = NewE(func() (T, error) { return fn(key) })
+        entries[key] = e
+        mu.Unlock()
+
+        // 已完成时回调会立即执行，因此必须在释放锁之后注册
+        e.f.OnComplete(func(_ T, err error) {
+            mu.Lock()
+            defer mu.Unlock()
+            if entries[key] != e {
+                return
+            }
+            if err != nil {
+                delete(entries, key)
+            } else if ttl > 0 {
+                e.expires = time.Now().Add(ttl)
+            }
+        })
+        return e.f
+    }
+}
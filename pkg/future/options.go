@@ -0,0 +1,77 @@
+package future
+
+import (
+    "time"
+)
+
+// ==================== 时钟 ====================
+
+// Clock 时间来源，测试中可替换为 fakeclock 以避免真实等待
+type Clock interface {
+    Now() time.Time
+    After(d time.Duration) <-chan time.Time
+    // NewTimer 创建定时器，返回到期通道和停止函数
+    NewTimer(d time.Duration) (<-chan time.Time, func() bool)
+}
+
+// realClock 基于 time 包的时钟
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+func (realClock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+    t := time.NewTimer(d)
+    return t.C, t.Stop
+}
+
+// ==================== 构造选项 ====================
+
+// options Future 构造配置
+type options struct {
+    clock Clock
+    name  string
+}
+
+// Option Future 构造选项
+type Option func(*options)
+
+// WithClock 指定 GetWithTimeout、Wait 等超时使用的时钟
+func WithClock(c Clock) Option {
+    return func(o *options) { o.clock = c }
+}
+
+// WithName 为 Future 命名，名称会出现在 DumpPending 的输出中
+func WithName(name string) Option {
+    return func(o *options) { o.name = name }
+}
+
+func applyOptions(opts []Option) options {
+    o := options{clock: realClock{}}
+    for _, opt := range opts {
+        opt(&o)
+    }
+    return o
+}
+
+// wait 等待 done 关闭，超时或 cancelled 关闭时返回 false
+// 已完成时不创建定时器；否则使用可停止的定时器，避免 After 在到期前一直占用定时器
+func (o options) wait(done, cancelled <-chan struct{}, timeout time.Duration) bool {
+    select {
+    case <-done:
+        return true
+    default:
+    }
+
+    expired, stop := o.clock.NewTimer(timeout)
+    defer stop()
+    select {
+    case <-done:
+        return true
+    case <-expired:
+        return false
+    case <-cancelled:
+        return false
+    }
+}
@@ -0,0 +1,84 @@
+package future
+
+import (
+    "context"
+    "sync"
+    "time"
+
+    "github.com/hunter-hongg/GoPlus/pkg/errorsx"
+)
+
+// PeriodicTask 周期性任务句柄
+type PeriodicTask struct {
+    ctx      context.Context
+    cancel   context.CancelFunc
+    stop     chan struct{}
+    stopOnce sync.Once
+    done     chan struct{}
+    errs     chan error
+}
+
+// Every 每隔 interval 运行一次 fn（首次运行在 interval 之后），上一次未结束时不会重叠运行
+// fn 返回的错误（包括 panic）发送到 Errors()，接收方跟不上时丢弃
+func Every(interval time.Duration, fn func(ctx context.Context) error) *PeriodicTask {
+    ctx, cancel := context.WithCancel(context.Background())
+    p := &PeriodicTask{
+        ctx:    ctx,
+        cancel: cancel,
+        stop:   make(chan struct{}),
+        done:   make(chan struct{}),
+        errs:   make(chan error, 16),
+    }
+    go p.loop(interval, fn)
+    return p
+}
+
+func (p *PeriodicTask) loop(interval time.Duration, fn func(ctx context.Context) error) {
+    defer close(p.done)
+    defer close(p.errs)
+
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+    for {
+        select {
+        case <-p.stop:
+            return
+        case <-p.ctx.Done():
+            return
+        case <-ticker.C:
+        }
+
+        if err := p.runOnce(fn); err != nil {
+            select {
+            case p.errs <- err:
+            default:
+            }
+        }
+    }
+}
+
+func (p *PeriodicTask) runOnce(fn func(ctx context.Context) error) (err error) {
+    defer errorsx.Recover(&err)
+    return fn(p.ctx)
+}
+
+// Errors 返回接收每次运行错误的通道，任务结束后关闭
+func (p *PeriodicTask) Errors() <-chan error {
+    return p.errs
+}
+
+// Stop 停止后续运行并等待正在进行的运行结束，不会取消其 ctx
+func (p *PeriodicTask) Stop() {
+    p.stopOnce.Do(func() { close(p.stop) })
+    <-p.done
+}
+
+// Cancel 停止后续运行并取消正在进行的运行的 ctx，不等待其结束
+func (p *PeriodicTask) Cancel() {
+    p.cancel()
+}
+
+// Done 返回任务完全结束时关闭的通道
+func (p *PeriodicTask) Done() <-chan struct{} {
+    return p.done
+}
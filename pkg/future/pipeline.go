@@ -0,0 +1,99 @@
+package future
+
+import (
+    "context"
+    "fmt"
+)
+
+// StageError 流水线某个阶段的错误（包括 panic），Unwrap 返回原始错误
+type StageError struct {
+    Stage string
+    Err   error
+}
+
+func (e *StageError) Error() string {
+    return fmt.Sprintf("future: stage %q: %v", e.Stage, e.Err)
+}
+
+func (e *StageError) Unwrap() error { return e.Err }
+
+// stage 流水线阶段，值在阶段之间以 any 传递
+// T 为接口类型时 nil 值会变成 nil any，因此取值统一经由 as
+type stage struct {
+    name string
+    fn   func(ctx context.Context, v any) (any, error)
+}
+
+// Pipeline 由命名阶段组成的流水线，按顺序处理源Future的结果
+// 类型不变的阶段使用 Stage 方法，改变类型的阶段使用 StageTo 函数
+type Pipeline[T any] struct {
+    run    func(ctx context.Context) (any, error)
+    stages []stage
+    hooks  func(stage string) Hooks
+}
+
+// Pipe 以 src 的结果作为流水线输入
+func Pipe[T any](src Future[T]) *Pipeline[T] {
+    return &Pipeline[T]{run: func(ctx context.Context) (any, error) {
+        if err := await(ctx, src); err != nil {
+            return nil, err
+        }
+        return src.GetE()
+    }}
+}
+
+// Stage 追加一个不改变类型的阶段
+func (p *Pipeline[T]) Stage(name string, fn func(ctx context.Context, v T) (T, error)) *Pipeline[T] {
+    return StageTo(p, name, fn)
+}
+
+// StageTo 追加一个把 T 转换为 U 的阶段
+func StageTo[T, U any](p *Pipeline[T], name string, fn func(ctx context.Context, v T) (U, error)) *Pipeline[U] {
+    stages := append(p.stages[:len(p.stages):len(p.stages)], stage{
+        name: name,
+        fn: func(ctx context.Context, v any) (any, error) {
+            return fn(ctx, as[T](v))
+        },
+    })
+    return &Pipeline[U]{run: p.run, stages: stages, hooks: p.hooks}
+}
+
+// WithStageHooks 为每个阶段设置钩子，hooks 接收阶段名，可直接传入 MetricsHooks
+// 未设置时各阶段使用全局钩子
+func (p *Pipeline[T]) WithStageHooks(hooks func(stage string) Hooks) *Pipeline[T] {
+    q := *p
+    q.hooks = hooks
+    return &q
+}
+
+// Run 启动流水线，任一阶段出错时后续阶段不再执行，错误为 *StageError
+// 取消返回的Future会取消 ctx 传给各阶段的子 ctx
+func (p *Pipeline[T]) Run(ctx context.Context, opts ...Option) Future[T] {
+    return NewCtx(ctx, func(ctx context.Context) (T, error) {
+        var zero T
+        v, err := p.run(ctx)
+        if err != nil {
+            return zero, err
+        }
+        for _, s := range p.stages {
+            var h *Hooks
+            if p.hooks != nil {
+                hooks := p.hooks(s.name)
+                h = &hooks
+            }
+            invoke(h, ctx, &err, func() {
+                v, err = s.fn(ctx, v)
+            })
+            if err != nil {
+                return zero, &StageError{Stage: s.name, Err: err}
+            }
+        }
+        return as[T](v), nil
+    }, opts...)
+}
+
+// as 把阶段间传递的值还原为 T，nil 还原为 T 的零值
+func as[T any](v any) T {
+    t, _ := v.(T)
+    return t
+}
@@ -0,0 +1,93 @@
+package future
+
+import (
+    "context"
+    "sync"
+)
+
+// Progress 进度快照
+type Progress struct {
+    Completed int64
+    Total     int64
+}
+
+// ReportFunc 任务用来报告进度的回调
+type ReportFunc func(completed, total int64)
+
+// ProgressFuture 可报告进度的Future
+type ProgressFuture[T any] struct {
+    Future[T]
+
+    mu       sync.Mutex
+    progress Progress
+    subs     []chan Progress
+    finished bool
+}
+
+// NewProgress 创建可报告进度的Future，fn 通过 report 更新进度
+func NewProgress[T any](fn func(report ReportFunc) (T, error)) *ProgressFuture[T] {
+    return NewProgressWithContext(context.Background(), func(_ context.Context, report ReportFunc) (T, error) {
+        return fn(report)
+    })
+}
+
+// NewProgressWithContext 创建带Context、可报告进度的Future，fn 接收Future自身的 ctx
+func NewProgressWithContext[T any](ctx context.Context, fn func(ctx context.Context, report ReportFunc) (T, error)) *ProgressFuture[T] {
+    p := &ProgressFuture[T]{}
+    f := newImpl[T](ctx)
+    p.Future = f
+    f.OnComplete(func(T, error) { p.finish() })
+    go f.executeWithError(func() (T, error) {
+        return fn(f.ctx, p.report)
+    })
+    return p
+}
+
+// Progress 返回最近一次报告的进度
+func (p *ProgressFuture[T]) Progress() (completed, total int64) {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    return p.progress.Completed, p.progress.Total
+}
+
+// Updates 返回接收进度更新的通道，只保留最新的一次更新，任务结束后关闭
+// 订阅时会先收到当前进度
+func (p *ProgressFuture[T]) Updates() <-chan Progress {
+    ch := make(chan Progress, 1)
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    ch <- p.progress
+    if p.finished {
+        close(ch)
+        return ch
+    }
+    p.subs = append(p.subs, ch)
+    return ch
+}
+
+func (p *ProgressFuture[T]) report(completed, total int64) {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    if p.finished {
+        return
+    }
+    p.progress = Progress{Completed: completed, Total: total}
+    for _, ch := range p.subs {
+        // 丢弃尚未被读取的旧进度
+        select {
+        case <-ch:
+        default:
+        }
+        ch <- p.progress
+    }
+}
+
+func (p *ProgressFuture[T]) finish() {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    p.finished = true
+    for _, ch := range p.subs {
+        close(ch)
+    }
+    p.subs = nil
+}
@@ -0,0 +1,66 @@
+package future
+
+import (
+    "context"
+    "errors"
+    "sync/atomic"
+)
+
+// ErrPromiseCompleted Promise 已经完成，不能再次完成
+var ErrPromiseCompleted = errors.New("future: promise already completed")
+
+// Promise 可手动完成的Future，用于把回调式接口适配为Future
+type Promise[T any] struct {
+    f         *futureImpl[T]
+    completed atomic.Bool
+    stop      func() bool
+}
+
+// NewPromise 创建Promise
+func NewPromise[T any]() *Promise[T] {
+    return NewPromiseWithContext[T](context.Background())
+}
+
+// NewPromiseWithContext 创建带Context的Promise
+// ctx 结束或 Future().Cancel() 时，若尚未完成则以 ctx 的错误失败
+func NewPromiseWithContext[T any](ctx context.Context) *Promise[T] {
+    p := &Promise[T]{f: newImpl[T](ctx)}
+    p.stop = context.AfterFunc(p.f.ctx, func() {
+        var zero T
+        p.settle(zero, p.f.ctx.Err())
+    })
+    return p
+}
+
+// Complete 以 v 成功完成，重复完成时返回 ErrPromiseCompleted
+func (p *Promise[T]) Complete(v T) error {
+    if err := p.settle(v, nil); err != nil {
+        return err
+    }
+    p.stop()
+    return nil
+}
+
+// Fail 以 err 失败，重复完成时返回 ErrPromiseCompleted
+func (p *Promise[T]) Fail(err error) error {
+    var zero T
+    if e := p.settle(zero, err); e != nil {
+        return e
+    }
+    p.stop()
+    return nil
+}
+
+func (p *Promise[T]) settle(v T, err error) error {
+    if !p.completed.CompareAndSwap(false, true) {
+        return ErrPromiseCompleted
+    }
+    p.f.result, p.f.err = v, err
+    p.f.finish()
+    return nil
+}
+
+// Future 返回与该 Promise 关联的Future
+func (p *Promise[T]) Future() Future[T] {
+    return p.f
+}
@@ -0,0 +1,33 @@
+package future
+
+import (
+    "context"
+
+    "github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// NewR 创建结果为 option.Result 的Future
+// Future 自身的错误（取消或 panic）也会转换为 Err 结果，因此 Get() 总能得到完整的结果
+func NewR[T any](fn func() option.Result[T, error], opts ...Option) Future[option.Result[T, error]] {
+    f := newImpl[option.Result[T, error]](context.Background(), opts...)
+    go func() {
+        defer f.finish()
+        invoke(f.hooks, f.ctx, &f.err, func() {
+            f.start()
+            f.result = fn()
+        })
+        if f.err != nil {
+            f.result = option.Err[T](f.err)
+        }
+    }()
+    return f
+}
+
+// ToResult 等待 f 完成并把结果转换为 option.Result
+func ToResult[T any](f Future[T]) option.Result[T, error] {
+    v, err := f.GetE()
+    if err != nil {
+        return option.Err[T](err)
+    }
+    return option.Ok[T, error](v)
+}
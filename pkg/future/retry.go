@@ -0,0 +1,105 @@
+package future
+
+import (
+    "context"
+    "math/rand/v2"
+    "time"
+)
+
+// ==================== 重试配置 ====================
+
+// retryConfig 重试配置
+type retryConfig struct {
+    maxAttempts int
+    initial     time.Duration
+    maxDelay    time.Duration
+    multiplier  float64
+    jitter      float64
+    retryIf     func(error) bool
+}
+
+// RetryOption 重试配置选项
+type RetryOption func(*retryConfig)
+
+// MaxAttempts 设置最大尝试次数（包含第一次），默认 3
+func MaxAttempts(n int) RetryOption {
+    return func(c *retryConfig) { c.maxAttempts = n }
+}
+
+// FixedBackoff 每次重试前等待固定时长
+func FixedBackoff(d time.Duration) RetryOption {
+    return func(c *retryConfig) {
+        c.initial = d
+        c.maxDelay = d
+        c.multiplier = 1
+    }
+}
+
+// ExponentialBackoff 第一次重试前等待 initial，之后每次翻倍，最长不超过 maxDelay（<=0 表示不限制）
+func ExponentialBackoff(initial, maxDelay time.Duration) RetryOption {
+    return func(c *retryConfig) {
+        c.initial = initial
+        c.maxDelay = maxDelay
+        c.multiplier = 2
+    }
+}
+
+// Jitter 为每次等待增加 [0, fraction*delay) 的随机时长，fraction 取值 0~1
+func Jitter(fraction float64) RetryOption {
+    return func(c *retryConfig) { c.jitter = fraction }
+}
+
+// RetryIf 只在 pred 返回 true 时重试，默认任何错误都重试
+func RetryIf(pred func(error) bool) RetryOption {
+    return func(c *retryConfig) { c.retryIf = pred }
+}
+
+// delay 返回第 n 次重试（从 1 开始）前的等待时长
+func (c *retryConfig) delay(n int) time.Duration {
+    d := float64(c.initial)
+    for i := 1; i < n; i++ {
+        d *= c.multiplier
+        if c.maxDelay > 0 && d >= float64(c.maxDelay) {
+            d = float64(c.maxDelay)
+            break
+        }
+    }
+    if c.jitter > 0 && d > 0 {
+        d += rand.Float64() * c.jitter * d
+    }
+    return time.Duration(d)
+}
+
+// ==================== 重试 ====================
+
+// Retry 执行 fn，失败时按配置重试，所有尝试失败后返回最后一次的错误
+// 取消返回的Future会中止等待中的重试
+func Retry[T any](fn func() (T, error), opts ...RetryOption) Future[T] {
+    cfg := retryConfig{maxAttempts: 3, multiplier: 1}
+    for _, opt := range opts {
+        opt(&cfg)
+    }
+
+    f := newImpl[T](context.Background())
+    go f.executeWithError(func() (T, error) {
+        for attempt := 1; ; attempt++ {
+            v, err := fn()
+            if err == nil || attempt >= cfg.maxAttempts || (cfg.retryIf != nil && !cfg.retryIf(err)) {
+                return v, err
+            }
+
+            if d := cfg.delay(attempt); d > 0 {
+                t := time.NewTimer(d)
+                select {
+                case <-t.C:
+                case <-f.ctx.Done():
+                    t.Stop()
+                    return v, f.ctx.Err()
+                }
+            } else if f.ctx.Err() != nil {
+                return v, f.ctx.Err()
+            }
+        }
+    })
+    return f
+}
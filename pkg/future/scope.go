@@ -0,0 +1,113 @@
+package future
+
+import (
+    "context"
+    "errors"
+    "sync"
+
+    "github.com/hunter-hongg/GoPlus/pkg/errorsx"
+)
+
+// ErrScopeClosed Scope 已经结束，不能再启动任务
+var ErrScopeClosed = errors.New("future: scope closed")
+
+// Scope 结构化并发作用域：其中启动的所有任务都会在 Scoped 返回前结束
+// 任一任务出错会取消作用域的 ctx，从而通知其余任务尽快退出
+type Scope struct {
+    ctx    context.Context
+    cancel context.CancelCauseFunc
+
+    mu  sync.Mutex
+    err error
+    // running 为 body 与尚未结束的任务数，归零时作用域关闭并关闭 idle
+    running int
+    closed  bool
+    idle    chan struct{}
+}
+
+// Scoped 运行 body 并等待其中启动的所有任务结束后返回
+// 返回 body 的错误或第一个失败任务的错误；ctx 结束时作用域内的任务同样会被取消
+func Scoped(ctx context.Context, body func(s *Scope) error) error {
+    ctx, cancel := context.WithCancelCause(ctx)
+    s := &Scope{ctx: ctx, cancel: cancel, running: 1, idle: make(chan struct{})}
+
+    var err error
+    func() {
+        defer errorsx.Recover(&err)
+        err = body(s)
+    }()
+    if err != nil {
+        s.fail(err)
+    }
+
+    // 任务仍在运行时作用域保持打开，任务可以继续启动同级任务
+    s.release()
+    <-s.idle
+    cancel(nil)
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return s.err
+}
+
+// Context 返回作用域的 ctx
+func (s *Scope) Context() context.Context {
+    return s.ctx
+}
+
+// Go 在作用域内启动无返回值的任务
+func (s *Scope) Go(fn func(ctx context.Context) error) {
+    Spawn(s, func(ctx context.Context) (struct{}, error) {
+        return struct{}{}, fn(ctx)
+    })
+}
+
+// Cancel 取消作用域内的所有任务，Scoped 返回 context.Canceled（除非已有其他错误）
+func (s *Scope) Cancel() {
+    s.fail(context.Canceled)
+}
+
+// fail 记录第一个错误并取消作用域
+func (s *Scope) fail(err error) {
+    s.mu.Lock()
+    if s.err == nil {
+        s.err = err
+    }
+    s.mu.Unlock()
+    s.cancel(err)
+}
+
+// release 在 body 或任务结束时调用，最后一个结束者关闭作用域
+func (s *Scope) release() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.running--
+    if s.running == 0 {
+        s.closed = true
+        close(s.idle)
+    }
+}
+
+// Spawn 在作用域内启动任务，fn 接收作用域的 ctx
+// 只要 body 或任一任务仍在运行就可以启动；作用域已结束时记录 ErrScopeClosed
+// 并返回以其失败的Future
+func Spawn[T any](s *Scope, fn func(ctx context.Context) (T, error)) Future[T] {
+    s.mu.Lock()
+    if s.closed {
+        s.mu.Unlock()
+        s.fail(ErrScopeClosed)
+        var zero T
+        return NewE(func() (T, error) { return zero, ErrScopeClosed })
+    }
+    s.running++
+    s.mu.Unlock()
+
+    f := NewCtx(s.ctx, fn)
+    f.OnComplete(func(_ T, err error) {
+        defer s.release()
+        if err != nil {
+            s.fail(err)
+        }
+    })
+    return f
+}
@@ -0,0 +1,67 @@
+package future
+
+import (
+    "context"
+)
+
+// Semaphore 计数信号量，用于限制同时运行的任务数
+type Semaphore struct {
+    slots chan struct{}
+}
+
+// NewSemaphore 创建容量为 n 的信号量，n 至少为 1
+func NewSemaphore(n int) *Semaphore {
+    if n < 1 {
+        n = 1
+    }
+    return &Semaphore{slots: make(chan struct{}, n)}
+}
+
+// Acquire 获取一个许可，ctx 结束时放弃并返回 ctx.Err()
+func (s *Semaphore) Acquire(ctx context.Context) error {
+    select {
+    case s.slots <- struct{}{}:
+        return nil
+    case <-ctx.Done():
+        return ctx.Err()
+    }
+}
+
+// TryAcquire 尝试立即获取一个许可
+func (s *Semaphore) TryAcquire() bool {
+    select {
+    case s.slots <- struct{}{}:
+        return true
+    default:
+        return false
+    }
+}
+
+// Release 归还一个许可
+func (s *Semaphore) Release() {
+    <-s.slots
+}
+
+// ==================== 受限的异步构造 ====================
+
+// AsyncLimited 先获取 sem 的许可再运行 fn，运行结束后归还
+// 等待许可期间取消Future会放弃等待，fn 不会运行
+func AsyncLimited[T any](sem *Semaphore, fn func() T) Future[T] {
+    return AsyncLimitedE(sem, func() (T, error) {
+        return fn(), nil
+    })
+}
+
+// AsyncLimitedE 与 AsyncLimited 相同，fn 返回(T, error)
+func AsyncLimitedE[T any](sem *Semaphore, fn func() (T, error)) Future[T] {
+    f := newImpl[T](context.Background())
+    go f.executeWithError(func() (T, error) {
+        if err := sem.Acquire(f.ctx); err != nil {
+            var zero T
+            return zero, err
+        }
+        defer sem.Release()
+        return fn()
+    })
+    return f
+}
@@ -0,0 +1,71 @@
+package future
+
+import (
+    "sync"
+
+    "github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// SharedFuture 把一个Future的结果分发给多个订阅者
+// 结果只计算一次，每个订阅者独立收到一份；完成后才订阅的会立即收到结果
+type SharedFuture[T any] struct {
+    f Future[T]
+
+    mu   sync.Mutex
+    done bool
+    res  option.Result[T, error]
+    subs []func(option.Result[T, error])
+}
+
+// Share 包装 f 以便多个订阅者共享其结果
+func Share[T any](f Future[T]) *SharedFuture[T] {
+    s := &SharedFuture[T]{f: f}
+    go func() {
+        res := ToResult(f)
+
+        s.mu.Lock()
+        s.done = true
+        s.res = res
+        subs := s.subs
+        s.subs = nil
+        s.mu.Unlock()
+
+        for _, fn := range subs {
+            fn(res)
+        }
+    }()
+    return s
+}
+
+// Subscribe 返回只会收到一次结果的通道，结果送达后通道关闭
+func (s *SharedFuture[T]) Subscribe() <-chan option.Result[T, error] {
+    ch := make(chan option.Result[T, error], 1)
+    s.SubscribeFunc(func(res option.Result[T, error]) {
+        ch <- res
+        close(ch)
+    })
+    return ch
+}
+
+// SubscribeFunc 在结果就绪时调用 fn；已完成时在当前 goroutine 立即调用
+func (s *SharedFuture[T]) SubscribeFunc(fn func(option.Result[T, error])) {
+    s.mu.Lock()
+    if !s.done {
+        s.subs = append(s.subs, fn)
+        s.mu.Unlock()
+        return
+    }
+    res := s.res
+    s.mu.Unlock()
+    fn(res)
+}
+
+// Future 返回被共享的Future
+func (s *SharedFuture[T]) Future() Future[T] {
+    return s.f
+}
+
+// Done 返回结果就绪时关闭的通道
+func (s *SharedFuture[T]) Done() <-chan struct{} {
+    return s.f.Done()
+}
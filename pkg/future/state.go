@@ -0,0 +1,79 @@
+package future
+
+import (
+    "context"
+    "errors"
+    "sync/atomic"
+)
+
+// State Future 的生命周期状态
+type State int32
+
+const (
+    // StatePending 已创建，任务尚未开始
+    StatePending State = iota
+    // StateRunning 任务正在运行
+    StateRunning
+    // StateDone 任务已结束（包括返回错误）
+    StateDone
+    // StateCancelled 任务因取消或超时结束，或在开始前被取消
+    StateCancelled
+    // StatePanicked 任务 panic 结束
+    StatePanicked
+)
+
+func (s State) String() string {
+    switch s {
+    case StatePending:
+        return "pending"
+    case StateRunning:
+        return "running"
+    case StateDone:
+        return "done"
+    case StateCancelled:
+        return "cancelled"
+    case StatePanicked:
+        return "panicked"
+    default:
+        return "unknown"
+    }
+}
+
+// Terminal 是否为结束状态
+func (s State) Terminal() bool {
+    return s >= StateDone
+}
+
+// terminalState 根据任务的错误确定结束状态
+func terminalState(err error) State {
+    switch {
+    case err == nil:
+        return StateDone
+    case errors.Is(err, ErrPanic):
+        return StatePanicked
+    case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+        return StateCancelled
+    default:
+        return StateDone
+    }
+}
+
+// lifecycle 记录 Future 的状态，嵌入各实现结构体
+type lifecycle struct {
+    state atomic.Int32
+}
+
+// State 返回当前状态，不会阻塞
+func (l *lifecycle) State() State {
+    return State(l.state.Load())
+}
+
+// start 标记任务开始运行，在 invoke 的 fn 内调用
+func (l *lifecycle) start() {
+    l.state.CompareAndSwap(int32(StatePending), int32(StateRunning))
+}
+
+// settle 根据任务的错误设置结束状态，在结果写入之后、done 关闭之前调用
+func (l *lifecycle) settle(err error) {
+    l.state.Store(int32(terminalState(err)))
+}
@@ -0,0 +1,96 @@
+package future
+
+import (
+    "context"
+
+    "github.com/hunter-hongg/GoPlus/pkg/errorsx"
+)
+
+// Stream 异步产生多个值的数据源，生产者通过 emit 逐个推送，消费者按顺序读取
+type Stream[T any] struct {
+    ctx    context.Context
+    cancel context.CancelFunc
+    ch     chan T
+    done   chan struct{}
+    err    error
+}
+
+// NewStream 创建 Stream 并在后台运行生产者
+// emit 在消费者取走值后返回 true，Stream 被取消后返回 false，此时生产者应尽快返回
+func NewStream[T any](fn func(ctx context.Context, emit func(T) bool) error) *Stream[T] {
+    return NewStreamWithContext(context.Background(), fn)
+}
+
+// NewStreamWithContext 创建带Context的 Stream
+func NewStreamWithContext[T any](ctx context.Context, fn func(ctx context.Context, emit func(T) bool) error) *Stream[T] {
+    ctx, cancel := context.WithCancel(ctx)
+    s := &Stream[T]{
+        ctx:    ctx,
+        cancel: cancel,
+        ch:     make(chan T),
+        done:   make(chan struct{}),
+    }
+    go s.produce(fn)
+    return s
+}
+
+func (s *Stream[T]) produce(fn func(ctx context.Context, emit func(T) bool) error) {
+    defer close(s.done)
+    defer close(s.ch)
+    defer errorsx.Recover(&s.err)
+
+    s.err = fn(s.ctx, func(v T) bool {
+        select {
+        case s.ch <- v:
+            return true
+        case <-s.ctx.Done():
+            return false
+        }
+    })
+    if s.err == nil && s.ctx.Err() != nil {
+        s.err = s.ctx.Err()
+    }
+}
+
+// Next 返回下一个值；流结束或 ctx 结束时返回 false
+func (s *Stream[T]) Next(ctx context.Context) (T, bool) {
+    select {
+    case v, ok := <-s.ch:
+        return v, ok
+    case <-ctx.Done():
+        var zero T
+        return zero, false
+    }
+}
+
+// Chan 返回值通道，流结束后关闭，可直接 range
+func (s *Stream[T]) Chan() <-chan T {
+    return s.ch
+}
+
+// Err 等待生产者结束并返回其错误；被取消时返回 context.Canceled
+func (s *Stream[T]) Err() error {
+    <-s.done
+    return s.err
+}
+
+// Done 返回生产者结束时关闭的通道
+func (s *Stream[T]) Done() <-chan struct{} {
+    return s.done
+}
+
+// Cancel 取消 Stream，生产者的 emit 随后返回 false
+func (s *Stream[T]) Cancel() {
+    s.cancel()
+}
+
+// Collect 读取剩余的全部值，生产者出错时Future以该错误失败
+func (s *Stream[T]) Collect() Future[[]T] {
+    return NewE(func() ([]T, error) {
+        var out []T
+        for v := range s.ch {
+            out = append(out, v)
+        }
+        return out, s.Err()
+    })
+}
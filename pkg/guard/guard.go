@@ -0,0 +1,119 @@
+// Package guard 提供区分正常返回与失败退出的作用域守卫。
+// 本包的函数均返回需要被 defer 调用的函数，例如：
+//
+//	func transfer() (err error) {
+//		tx := begin()
+//		defer guard.OnFailure(tx.Rollback, &err)()
+//		defer guard.OnSuccess(tx.Commit, &err)()
+//		...
+//	}
+//
+// 返回的函数必须直接由 defer 调用，否则无法识别 panic。
+// panic 时先执行清理函数，然后继续向上抛出原 panic。
+package guard
+
+import (
+	"sync/atomic"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// OnExit 无论正常返回、返回错误还是 panic 都执行 fn
+func OnExit(fn func()) func() {
+	return func() {
+		fn()
+	}
+}
+
+// OnSuccess 正常返回且 *errp 为 nil 时执行 fn
+// 省略 errp 时只根据是否 panic 判断
+func OnSuccess(fn func(), errp ...*error) func() {
+	return func() {
+		if r := recover(); r != nil {
+			panic(r)
+		}
+		if !failed(errp) {
+			fn()
+		}
+	}
+}
+
+// OnFailure panic 或 *errp 不为 nil 时执行 fn
+// 省略 errp 时只在 panic 时执行
+func OnFailure(fn func(), errp ...*error) func() {
+	return func() {
+		if r := recover(); r != nil {
+			fn()
+			panic(r)
+		}
+		if failed(errp) {
+			fn()
+		}
+	}
+}
+
+// OnSuccessResult 正常返回且 *res 为 Ok 时执行 fn
+func OnSuccessResult[T, E any](res *option.Result[T, E], fn func()) func() {
+	return func() {
+		if r := recover(); r != nil {
+			panic(r)
+		}
+		if res.IsOk() {
+			fn()
+		}
+	}
+}
+
+// OnFailureResult panic 或 *res 为 Err 时执行 fn
+func OnFailureResult[T, E any](res *option.Result[T, E], fn func()) func() {
+	return func() {
+		if r := recover(); r != nil {
+			fn()
+			panic(r)
+		}
+		if res.IsErr() {
+			fn()
+		}
+	}
+}
+
+func failed(errp []*error) bool {
+	for _, p := range errp {
+		if p != nil && *p != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ============================================================================
+// 可撤销的守卫
+// ============================================================================
+
+// Guard 可撤销的作用域守卫：除非在退出前调用 Dismiss，否则执行清理函数
+//
+//	g := guard.New(rollback)
+//	defer g.Run()
+//	...
+//	g.Dismiss() // 一切顺利，不再回滚
+type Guard struct {
+	fn        func()
+	dismissed atomic.Bool
+}
+
+// New 创建守卫
+func New(fn func()) *Guard {
+	return &Guard{fn: fn}
+}
+
+// Dismiss 撤销守卫
+func (g *Guard) Dismiss() {
+	g.dismissed.Store(true)
+}
+
+// Run 在未被撤销时执行清理函数，应当由 defer 调用
+func (g *Guard) Run() {
+	if !g.dismissed.Load() {
+		g.fn()
+	}
+}
@@ -0,0 +1,159 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+	"github.com/hunter-hongg/GoPlus/pkg/tuple"
+)
+
+// ==================== Lazy ====================
+
+// Lazy 延迟求值的值，首次访问时计算且只计算一次，并发安全
+// 计算函数 panic 时，每次访问都会重新抛出同一个 panic
+type Lazy[T any] struct {
+	once   sync.Once
+	fn     func() T
+	value  T
+	forced atomic.Bool
+	panic  any
+}
+
+// New 创建延迟值
+func New[T any](fn func() T) *Lazy[T] {
+	return &Lazy[T]{fn: fn}
+}
+
+// Of 创建已求值的延迟值
+func Of[T any](v T) *Lazy[T] {
+	l := &Lazy[T]{value: v}
+	l.once.Do(func() {})
+	l.forced.Store(true)
+	return l
+}
+
+// Get 返回值，必要时先求值
+func (l *Lazy[T]) Get() T {
+	l.once.Do(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				l.panic = r
+			}
+			l.fn = nil
+		}()
+		l.value = l.fn()
+		l.forced.Store(true)
+	})
+	if l.panic != nil {
+		panic(l.panic)
+	}
+	return l.value
+}
+
+// IsForced 是否已经求值
+func (l *Lazy[T]) IsForced() bool { return l.forced.Load() }
+
+// ForcedValue 在已求值时返回 Some，不会触发求值
+func (l *Lazy[T]) ForcedValue() option.Option[T] {
+	if l.forced.Load() {
+		return option.Some(l.value)
+	}
+	return option.None[T]()
+}
+
+// ==================== LazyE ====================
+
+// LazyE 可能失败的延迟值，错误同样被记忆
+type LazyE[T any] struct {
+	once   sync.Once
+	fn     func() (T, error)
+	value  T
+	err    error
+	forced atomic.Bool
+	panic  any
+}
+
+// NewE 创建可能失败的延迟值
+func NewE[T any](fn func() (T, error)) *LazyE[T] {
+	return &LazyE[T]{fn: fn}
+}
+
+// Get 返回值和错误，必要时先求值
+func (l *LazyE[T]) Get() (T, error) {
+	l.once.Do(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				l.panic = r
+			}
+			l.fn = nil
+		}()
+		l.value, l.err = l.fn()
+		l.forced.Store(true)
+	})
+	if l.panic != nil {
+		panic(l.panic)
+	}
+	return l.value, l.err
+}
+
+// Result 以 Result 形式返回求值结果
+func (l *LazyE[T]) Result() option.Result[T, error] {
+	v, err := l.Get()
+	if err != nil {
+		return option.Err[T](err)
+	}
+	return option.Ok[T, error](v)
+}
+
+// IsForced 是否已经求值
+func (l *LazyE[T]) IsForced() bool { return l.forced.Load() }
+
+// ForcedValue 在已成功求值时返回 Some，不会触发求值
+func (l *LazyE[T]) ForcedValue() option.Option[T] {
+	if l.forced.Load() && l.err == nil {
+		return option.Some(l.value)
+	}
+	return option.None[T]()
+}
+
+// Future 在后台开始求值并返回对应的 Future，结果与 Get 共享
+func (l *LazyE[T]) Future() future.Future[T] {
+	return future.NewE(l.Get)
+}
+
+// ==================== 组合函数 ====================
+
+// Map 延迟地转换值，访问结果时才会对源求值
+func Map[T, U any](l *Lazy[T], f func(T) U) *Lazy[U] {
+	return New(func() U {
+		return f(l.Get())
+	})
+}
+
+// MapE 延迟地转换可能失败的值，源失败时不调用 f
+func MapE[T, U any](l *LazyE[T], f func(T) (U, error)) *LazyE[U] {
+	return NewE(func() (U, error) {
+		v, err := l.Get()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return f(v)
+	})
+}
+
+// Zip 将两个延迟值合并为一个 Pair，访问时依次求值
+func Zip[A, B any](a *Lazy[A], b *Lazy[B]) *Lazy[tuple.Pair[A, B]] {
+	return New(func() tuple.Pair[A, B] {
+		return tuple.NewPair(a.Get(), b.Get())
+	})
+}
+
+// ToOption 延迟地将 LazyE 转换为 Option，错误视为 None
+func ToOption[T any](l *LazyE[T]) *Lazy[option.Option[T]] {
+	return New(func() option.Option[T] {
+		return l.Result().ToOption()
+	})
+}
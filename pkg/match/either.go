@@ -0,0 +1,51 @@
+package match
+
+import "github.com/hunter-hongg/GoPlus/pkg/either"
+
+// EitherMatcher 匹配 Either
+type EitherMatcher[L, R, U any] struct {
+	e       either.Either[L, R]
+	matched bool
+	result  U
+}
+
+// Either 开始匹配 Either
+func Either[L, R, U any](e either.Either[L, R]) *EitherMatcher[L, R, U] {
+	return &EitherMatcher[L, R, U]{e: e}
+}
+
+// LeftIf 为 Left 且满足 pred 时调用 fn
+func (m *EitherMatcher[L, R, U]) LeftIf(pred func(L) bool, fn func(L) U) *EitherMatcher[L, R, U] {
+	if !m.matched && m.e.IsLeft() && pred(m.e.UnwrapLeft()) {
+		m.result = fn(m.e.UnwrapLeft())
+		m.matched = true
+	}
+	return m
+}
+
+// Left 为 Left 时调用 fn
+func (m *EitherMatcher[L, R, U]) Left(fn func(L) U) *EitherMatcher[L, R, U] {
+	return m.LeftIf(func(L) bool { return true }, fn)
+}
+
+// RightIf 为 Right 且满足 pred 时调用 fn
+func (m *EitherMatcher[L, R, U]) RightIf(pred func(R) bool, fn func(R) U) *EitherMatcher[L, R, U] {
+	if !m.matched && m.e.IsRight() && pred(m.e.UnwrapRight()) {
+		m.result = fn(m.e.UnwrapRight())
+		m.matched = true
+	}
+	return m
+}
+
+// Right 为 Right 时调用 fn
+func (m *EitherMatcher[L, R, U]) Right(fn func(R) U) *EitherMatcher[L, R, U] {
+	return m.RightIf(func(R) bool { return true }, fn)
+}
+
+// Eval 返回匹配结果，没有分支匹配时 panic ErrNoMatch
+func (m *EitherMatcher[L, R, U]) Eval() U {
+	if !m.matched {
+		panic(ErrNoMatch)
+	}
+	return m.result
+}
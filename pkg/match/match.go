@@ -0,0 +1,268 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// ErrNoMatch 没有分支匹配且没有默认分支
+var ErrNoMatch = errors.New("match: no case matched")
+
+// NonExhaustiveError 穷尽模式下有已注册的变体没有对应分支
+type NonExhaustiveError struct {
+	Union   reflect.Type
+	Missing []reflect.Type
+}
+
+func (e *NonExhaustiveError) Error() string {
+	names := make([]string, len(e.Missing))
+	for i, t := range e.Missing {
+		names[i] = t.String()
+	}
+	return fmt.Sprintf("match: non-exhaustive match on %s, missing: %s", e.Union, strings.Join(names, ", "))
+}
+
+// ============================================================================
+// 联合类型注册
+// ============================================================================
+
+var (
+	unionsMu sync.RWMutex
+	unions   = make(map[reflect.Type][]reflect.Type)
+)
+
+// Register 将 variants 的具体类型登记为接口 U 的全部变体，用于穷尽检查
+// 重复注册会覆盖之前的登记
+func Register[U any](variants ...U) {
+	union := reflect.TypeFor[U]()
+	if union.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("match: Register requires an interface type, got %s", union))
+	}
+	types := make([]reflect.Type, 0, len(variants))
+	for _, v := range variants {
+		types = append(types, reflect.TypeOf(v))
+	}
+
+	unionsMu.Lock()
+	defer unionsMu.Unlock()
+	unions[union] = types
+}
+
+// ============================================================================
+// 通用匹配器
+// ============================================================================
+
+// Matcher 按值的动态类型选择分支
+type Matcher[R any] struct {
+	value   any
+	union   reflect.Type
+	cases   []reflect.Type
+	matched bool
+	result  R
+}
+
+// Value 开始匹配任意值
+func Value[R any](x any) *Matcher[R] {
+	return &Matcher[R]{value: x}
+}
+
+// Union 以穷尽模式匹配已注册的联合类型 U
+// Eval 时若有已注册变体没有对应分支会 panic
+func Union[U any, R any](x U) *Matcher[R] {
+	union := reflect.TypeFor[U]()
+	unionsMu.RLock()
+	_, ok := unions[union]
+	unionsMu.RUnlock()
+	if !ok {
+		panic(fmt.Sprintf("match: union type %s is not registered", union))
+	}
+	return &Matcher[R]{value: x, union: union}
+}
+
+// Case 添加一个分支，fn 必须是 func(T) R 形式
+// 值的动态类型可赋值给 T 时调用 fn，先匹配的分支优先
+func (m *Matcher[R]) Case(fn any) *Matcher[R] {
+	fv := reflect.ValueOf(fn)
+	ft := fv.Type()
+	resultType := reflect.TypeFor[R]()
+	if ft.Kind() != reflect.Func || ft.NumIn() != 1 || ft.NumOut() != 1 || !ft.Out(0).AssignableTo(resultType) {
+		panic(fmt.Sprintf("match: Case expects func(T) %s, got %s", resultType, ft))
+	}
+	in := ft.In(0)
+	m.cases = append(m.cases, in)
+
+	if m.matched || m.value == nil {
+		return m
+	}
+	if reflect.TypeOf(m.value).AssignableTo(in) {
+		out := fv.Call([]reflect.Value{reflect.ValueOf(m.value)})[0]
+		// R 为接口且返回 nil 时断言失败，保持零值即可
+		m.result, _ = out.Interface().(R)
+		m.matched = true
+	}
+	return m
+}
+
+// When 添加带守卫条件的分支，pred 为真时调用 fn
+func (m *Matcher[R]) When(pred func(any) bool, fn func(any) R) *Matcher[R] {
+	if !m.matched && pred(m.value) {
+		m.result = fn(m.value)
+		m.matched = true
+	}
+	return m
+}
+
+// Default 返回匹配结果，没有分支匹配时调用 fn
+func (m *Matcher[R]) Default(fn func() R) R {
+	if m.matched {
+		return m.result
+	}
+	return fn()
+}
+
+// Eval 返回匹配结果
+// 穷尽模式下先检查所有变体都有分支，否则 panic *NonExhaustiveError；
+// 没有分支匹配时 panic ErrNoMatch
+func (m *Matcher[R]) Eval() R {
+	if m.union != nil {
+		if err := m.checkExhaustive(); err != nil {
+			panic(err)
+		}
+	}
+	if !m.matched {
+		panic(fmt.Errorf("%w: %T", ErrNoMatch, m.value))
+	}
+	return m.result
+}
+
+// Result 以 Option 形式返回匹配结果
+func (m *Matcher[R]) Result() option.Option[R] {
+	if m.matched {
+		return option.Some(m.result)
+	}
+	return option.None[R]()
+}
+
+func (m *Matcher[R]) checkExhaustive() error {
+	unionsMu.RLock()
+	variants := unions[m.union]
+	unionsMu.RUnlock()
+
+	var missing []reflect.Type
+	for _, v := range variants {
+		covered := false
+		for _, c := range m.cases {
+			if v.AssignableTo(c) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) > 0 {
+		return &NonExhaustiveError{Union: m.union, Missing: missing}
+	}
+	return nil
+}
+
+// ============================================================================
+// Option / Result / Either 匹配器
+// ============================================================================
+
+// OptionMatcher 匹配 Option
+type OptionMatcher[T, R any] struct {
+	opt     option.Option[T]
+	matched bool
+	result  R
+}
+
+// Option 开始匹配 Option
+func Option[T, R any](o option.Option[T]) *OptionMatcher[T, R] {
+	return &OptionMatcher[T, R]{opt: o}
+}
+
+// SomeIf 值存在且满足 pred 时调用 fn
+func (m *OptionMatcher[T, R]) SomeIf(pred func(T) bool, fn func(T) R) *OptionMatcher[T, R] {
+	if !m.matched && m.opt.IsSome() && pred(m.opt.Unwrap()) {
+		m.result = fn(m.opt.Unwrap())
+		m.matched = true
+	}
+	return m
+}
+
+// Some 值存在时调用 fn
+func (m *OptionMatcher[T, R]) Some(fn func(T) R) *OptionMatcher[T, R] {
+	return m.SomeIf(func(T) bool { return true }, fn)
+}
+
+// None 值不存在时调用 fn
+func (m *OptionMatcher[T, R]) None(fn func() R) *OptionMatcher[T, R] {
+	if !m.matched && m.opt.IsNone() {
+		m.result = fn()
+		m.matched = true
+	}
+	return m
+}
+
+// Eval 返回匹配结果，没有分支匹配时 panic ErrNoMatch
+func (m *OptionMatcher[T, R]) Eval() R {
+	if !m.matched {
+		panic(ErrNoMatch)
+	}
+	return m.result
+}
+
+// ResultMatcher 匹配 Result
+type ResultMatcher[T, E, R any] struct {
+	res     option.Result[T, E]
+	matched bool
+	result  R
+}
+
+// Result 开始匹配 Result
+func Result[T, E, R any](r option.Result[T, E]) *ResultMatcher[T, E, R] {
+	return &ResultMatcher[T, E, R]{res: r}
+}
+
+// OkIf 成功且满足 pred 时调用 fn
+func (m *ResultMatcher[T, E, R]) OkIf(pred func(T) bool, fn func(T) R) *ResultMatcher[T, E, R] {
+	if !m.matched && m.res.IsOk() && pred(m.res.Unwrap()) {
+		m.result = fn(m.res.Unwrap())
+		m.matched = true
+	}
+	return m
+}
+
+// Ok 成功时调用 fn
+func (m *ResultMatcher[T, E, R]) Ok(fn func(T) R) *ResultMatcher[T, E, R] {
+	return m.OkIf(func(T) bool { return true }, fn)
+}
+
+// ErrIf 失败且满足 pred 时调用 fn
+func (m *ResultMatcher[T, E, R]) ErrIf(pred func(E) bool, fn func(E) R) *ResultMatcher[T, E, R] {
+	if !m.matched && m.res.IsErr() && pred(m.res.UnwrapErr()) {
+		m.result = fn(m.res.UnwrapErr())
+		m.matched = true
+	}
+	return m
+}
+
+// Err 失败时调用 fn
+func (m *ResultMatcher[T, E, R]) Err(fn func(E) R) *ResultMatcher[T, E, R] {
+	return m.ErrIf(func(E) bool { return true }, fn)
+}
+
+// Eval 返回匹配结果，没有分支匹配时 panic ErrNoMatch
+func (m *ResultMatcher[T, E, R]) Eval() R {
+	if !m.matched {
+		panic(ErrNoMatch)
+	}
+	return m.result
+}
@@ -0,0 +1,211 @@
+package memo
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/metrics"
+)
+
+// ErrPanicked 加载函数 panic，等待中的调用方收到此错误
+var ErrPanicked = errors.New("memo: load function panicked")
+
+// ==================== 配置 ====================
+
+// config 记忆化配置
+type config struct {
+	ttl        time.Duration
+	maxEntries int
+	cacheErrs  bool
+	errTTL     time.Duration
+	name       string
+	now        func() time.Time
+}
+
+// Option 记忆化配置选项
+type Option func(*config)
+
+// WithTTL 设置成功结果的有效期，<=0 表示永不过期
+func WithTTL(ttl time.Duration) Option {
+	return func(c *config) { c.ttl = ttl }
+}
+
+// WithMaxEntries 设置最大缓存条目数，超出后按 LRU 淘汰，<=0 表示不限制
+func WithMaxEntries(n int) Option {
+	return func(c *config) { c.maxEntries = n }
+}
+
+// WithErrorCaching 缓存错误结果 ttl 时长，<=0 表示与成功结果使用相同的有效期
+// 默认不缓存错误，下一次调用会重新执行
+func WithErrorCaching(ttl time.Duration) Option {
+	return func(c *config) {
+		c.cacheErrs = true
+		c.errTTL = ttl
+	}
+}
+
+// WithName 设置上报指标时使用的 name 标签
+// 指标：memo_hits_total、memo_misses_total、memo_evictions_total
+func WithName(name string) Option {
+	return func(c *config) { c.name = name }
+}
+
+// ==================== 记忆化 ====================
+
+// entry 缓存条目
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	err     error
+	expires time.Time
+}
+
+// call 正在进行的加载
+type call[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// Memo 并发安全的记忆化函数
+// 同一个键的并发调用只会执行一次 fn，其余调用方等待并共享结果
+type Memo[K comparable, V any] struct {
+	fn  func(K) (V, error)
+	cfg config
+
+	mu       sync.Mutex
+	entries  map[K]*list.Element
+	lru      *list.List
+	inflight map[K]*call[V]
+}
+
+// Memoize 包装 fn 为记忆化函数
+func Memoize[K comparable, V any](fn func(K) (V, error), opts ...Option) *Memo[K, V] {
+	cfg := config{now: time.Now}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Memo[K, V]{
+		fn:       fn,
+		cfg:      cfg,
+		entries:  make(map[K]*list.Element),
+		lru:      list.New(),
+		inflight: make(map[K]*call[V]),
+	}
+}
+
+// Func 返回等价的普通函数
+func (m *Memo[K, V]) Func() func(K) (V, error) {
+	return m.Get
+}
+
+// Get 返回 key 对应的结果，未缓存或已过期时调用 fn
+func (m *Memo[K, V]) Get(key K) (V, error) {
+	m.mu.Lock()
+	if el, ok := m.entries[key]; ok {
+		e := el.Value.(*entry[K, V])
+		if e.expires.IsZero() || m.cfg.now().Before(e.expires) {
+			m.lru.MoveToFront(el)
+			m.mu.Unlock()
+			m.count("memo_hits_total")
+			return e.value, e.err
+		}
+		m.removeLocked(el)
+	}
+
+	if c, ok := m.inflight[key]; ok {
+		m.mu.Unlock()
+		m.count("memo_hits_total")
+		<-c.done
+		return c.value, c.err
+	}
+
+	c := &call[V]{done: make(chan struct{})}
+	m.inflight[key] = c
+	m.mu.Unlock()
+	m.count("memo_misses_total")
+
+	m.load(key, c)
+	return c.value, c.err
+}
+
+// load 执行 fn 并写入缓存；fn panic 时不缓存，但也会唤醒等待者
+func (m *Memo[K, V]) load(key K, c *call[V]) {
+	completed := false
+	defer func() {
+		if !completed {
+			c.err = ErrPanicked
+		}
+		m.mu.Lock()
+		// 加载期间被 Invalidate 时不再写回
+		if m.inflight[key] == c {
+			delete(m.inflight, key)
+			if completed && (c.err == nil || m.cfg.cacheErrs) {
+				m.storeLocked(key, c.value, c.err)
+			}
+		}
+		m.mu.Unlock()
+		close(c.done)
+	}()
+	c.value, c.err = m.fn(key)
+	completed = true
+}
+
+func (m *Memo[K, V]) storeLocked(key K, value V, err error) {
+	ttl := m.cfg.ttl
+	if err != nil && m.cfg.errTTL > 0 {
+		ttl = m.cfg.errTTL
+	}
+	e := &entry[K, V]{key: key, value: value, err: err}
+	if ttl > 0 {
+		e.expires = m.cfg.now().Add(ttl)
+	}
+	m.entries[key] = m.lru.PushFront(e)
+
+	if m.cfg.maxEntries > 0 {
+		for m.lru.Len() > m.cfg.maxEntries {
+			m.removeLocked(m.lru.Back())
+			m.count("memo_evictions_total")
+		}
+	}
+}
+
+func (m *Memo[K, V]) removeLocked(el *list.Element) {
+	e := m.lru.Remove(el).(*entry[K, V])
+	delete(m.entries, e.key)
+}
+
+// count 向全局指标提供者上报计数
+func (m *Memo[K, V]) count(name string) {
+	metrics.Default().Counter(name, metrics.L("name", m.cfg.name)).Inc()
+}
+
+// Invalidate 删除指定键的缓存，正在进行的加载结果不会被写回
+func (m *Memo[K, V]) Invalidate(keys ...K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		if el, ok := m.entries[key]; ok {
+			m.removeLocked(el)
+		}
+		delete(m.inflight, key)
+	}
+}
+
+// InvalidateAll 清空所有缓存
+func (m *Memo[K, V]) InvalidateAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = make(map[K]*list.Element)
+	m.lru.Init()
+	m.inflight = make(map[K]*call[V])
+}
+
+// Len 返回当前缓存的条目数（可能包含已过期但尚未清理的条目）
+func (m *Memo[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
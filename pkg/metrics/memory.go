@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hunter-hongg/GoPlus/pkg/atomicx"
+)
+
+// Memory 在内存中记录指标的提供者，适用于调试和测试
+type Memory struct {
+	mu         sync.Mutex
+	counters   map[string]*memCounter
+	gauges     map[string]*memGauge
+	histograms map[string]*memHistogram
+}
+
+// NewMemory 创建内存提供者
+func NewMemory() *Memory {
+	return &Memory{
+		counters:   make(map[string]*memCounter),
+		gauges:     make(map[string]*memGauge),
+		histograms: make(map[string]*memHistogram),
+	}
+}
+
+// key 由名称和排序后的标签组成
+func key(name string, labels []Label) string {
+	if len(labels) == 0 {
+		return name
+	}
+	parts := make([]string, len(labels))
+	for i, l := range labels {
+		parts[i] = l.Key + "=" + l.Value
+	}
+	sort.Strings(parts)
+	return name + "{" + strings.Join(parts, ",") + "}"
+}
+
+func (m *Memory) Counter(name string, labels ...Label) Counter {
+	return getOrCreate(m, m.counters, key(name, labels), func() *memCounter { return &memCounter{} })
+}
+
+func (m *Memory) Gauge(name string, labels ...Label) Gauge {
+	return getOrCreate(m, m.gauges, key(name, labels), func() *memGauge { return &memGauge{} })
+}
+
+func (m *Memory) Histogram(name string, labels ...Label) Histogram {
+	return getOrCreate(m, m.histograms, key(name, labels), func() *memHistogram { return &memHistogram{} })
+}
+
+func getOrCreate[V any](m *Memory, table map[string]V, k string, create func() V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := table[k]
+	if !ok {
+		v = create()
+		table[k] = v
+	}
+	return v
+}
+
+// Snapshot 返回所有计数器与仪表的当前值，以及直方图的观测次数与总和
+// 键的格式为 name 或 name{k1=v1,k2=v2}，直方图的键附加 _count 与 _sum 后缀
+func (m *Memory) Snapshot() map[string]float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make(map[string]float64, len(m.counters)+len(m.gauges)+2*len(m.histograms))
+	for k, v := range m.counters {
+		out[k] = v.v.Load()
+	}
+	for k, v := range m.gauges {
+		out[k] = v.v.Load()
+	}
+	for k, h := range m.histograms {
+		h.mu.Lock()
+		out[k+"_count"] = float64(h.count)
+		out[k+"_sum"] = h.sum
+		h.mu.Unlock()
+	}
+	return out
+}
+
+// memCounter 原子累加的计数器
+type memCounter struct {
+	v atomicx.Float64
+}
+
+func (c *memCounter) Add(delta float64) { c.v.Add(delta) }
+
+func (c *memCounter) Inc() { c.v.Add(1) }
+
+// memGauge 原子读写的仪表
+type memGauge struct {
+	v atomicx.Float64
+}
+
+func (g *memGauge) Set(x float64) { g.v.Store(x) }
+
+func (g *memGauge) Add(delta float64) { g.v.Add(delta) }
+
+// memHistogram 只记录次数与总和
+type memHistogram struct {
+	mu    sync.Mutex
+	count uint64
+	sum   float64
+}
+
+func (h *memHistogram) Observe(x float64) {
+	h.mu.Lock()
+	h.count++
+	h.sum += x
+	h.mu.Unlock()
+}
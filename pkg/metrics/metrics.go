@@ -0,0 +1,104 @@
+// Package metrics 定义各组件共用的最小化指标接口。
+//
+// 组件通过 Default() 上报指标，默认实现不做任何事情；
+// 调用 SetProvider 即可把所有组件接入同一个监控系统。例如接入 Prometheus：
+//
+//	type promProvider struct{ reg prometheus.Registerer }
+//
+//	func (p promProvider) Counter(name string, labels ...metrics.Label) metrics.Counter {
+//		c := prometheus.NewCounter(prometheus.CounterOpts{Name: name, ConstLabels: toPromLabels(labels)})
+//		if err := p.reg.Register(c); err != nil {
+//			var are prometheus.AlreadyRegisteredError
+//			if errors.As(err, &are) {
+//				return are.ExistingCollector.(prometheus.Counter)
+//			}
+//			panic(err)
+//		}
+//		return c
+//	}
+//	// Gauge 与 Histogram 同理；prometheus.Counter/Gauge/Histogram 已满足本包接口
+//
+//	metrics.SetProvider(promProvider{reg: prometheus.DefaultRegisterer})
+package metrics
+
+import (
+	"sync/atomic"
+)
+
+// Label 指标标签
+type Label struct {
+	Key   string
+	Value string
+}
+
+// L 创建标签
+func L(key, value string) Label {
+	return Label{Key: key, Value: value}
+}
+
+// Counter 单调递增的计数器
+type Counter interface {
+	Add(delta float64)
+	Inc()
+}
+
+// Gauge 可增可减的瞬时值
+type Gauge interface {
+	Set(v float64)
+	Add(delta float64)
+}
+
+// Histogram 观测值分布，例如耗时（秒）
+type Histogram interface {
+	Observe(v float64)
+}
+
+// Provider 指标实现的提供者
+// 相同名称和标签的多次调用应当返回同一个指标（或等价的指标）
+type Provider interface {
+	Counter(name string, labels ...Label) Counter
+	Gauge(name string, labels ...Label) Gauge
+	Histogram(name string, labels ...Label) Histogram
+}
+
+// ==================== 全局提供者 ====================
+
+// holder 包装 Provider 以便存入 atomic.Pointer
+type holder struct {
+	p Provider
+}
+
+var current atomic.Pointer[holder]
+
+func init() {
+	current.Store(&holder{p: Noop()})
+}
+
+// SetProvider 设置全局指标提供者，nil 恢复为空实现
+func SetProvider(p Provider) {
+	if p == nil {
+		p = Noop()
+	}
+	current.Store(&holder{p: p})
+}
+
+// Default 返回全局指标提供者
+func Default() Provider {
+	return current.Load().p
+}
+
+// ==================== 空实现 ====================
+
+type noop struct{}
+
+func (noop) Add(float64)     {}
+func (noop) Inc()            {}
+func (noop) Set(float64)     {}
+func (noop) Observe(float64) {}
+
+func (noop) Counter(string, ...Label) Counter     { return noop{} }
+func (noop) Gauge(string, ...Label) Gauge         { return noop{} }
+func (noop) Histogram(string, ...Label) Histogram { return noop{} }
+
+// Noop 返回不记录任何内容的提供者
+func Noop() Provider { return noop{} }
@@ -0,0 +1,31 @@
+package option
+
+// ============================================================================
+// 集合访问
+// ============================================================================
+
+// MapGet 返回 m[k]，键不存在时返回 None
+func MapGet[K comparable, V any](m map[K]V, k K) Option[V] {
+    if v, ok := m[k]; ok {
+        return Some(v)
+    }
+    return None[V]()
+}
+
+// SliceGet 返回 s[i]，越界时返回 None
+func SliceGet[T any](s []T, i int) Option[T] {
+    if i < 0 || i >= len(s) {
+        return None[T]()
+    }
+    return Some(s[i])
+}
+
+// First 返回第一个元素，切片为空时返回 None
+func First[T any](s []T) Option[T] {
+    return SliceGet(s, 0)
+}
+
+// Last 返回最后一个元素，切片为空时返回 None
+func Last[T any](s []T) Option[T] {
+    return SliceGet(s, len(s)-1)
+}
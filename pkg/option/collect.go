@@ -0,0 +1,45 @@
+package option
+
+// ============================================================================
+// 切片聚合
+// ============================================================================
+
+// CollectResults 全部为 Ok 时返回按顺序收集的值，否则返回第一个 Err
+func CollectResults[T, E any](rs []Result[T, E]) Result[[]T, E] {
+    values := make([]T, 0, len(rs))
+    for _, r := range rs {
+        if !r.ok {
+            return Err[[]T](r.err)
+        }
+        values = append(values, r.value)
+    }
+    return Ok[[]T, E](values)
+}
+
+// CollectOptions 全部为 Some 时返回按顺序收集的值，否则返回 None
+func CollectOptions[T any](opts []Option[T]) Option[[]T] {
+    values := make([]T, 0, len(opts))
+    for _, o := range opts {
+        if !o.present {
+            return None[[]T]()
+        }
+        values = append(values, o.value)
+    }
+    return Some(values)
+}
+
+// PartitionResults 把 Ok 的值和 Err 的错误分别按顺序收集，不会在第一个错误处停止
+func PartitionResults[T, E any](rs []Result[T, E]) ([]T, []E) {
+    var (
+        values []T
+        errs   []E
+    )
+    for _, r := range rs {
+        if r.ok {
+            values = append(values, r.value)
+        } else {
+            errs = append(errs, r.err)
+        }
+    }
+    return values, errs
+}
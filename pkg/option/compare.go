@@ -0,0 +1,40 @@
+package option
+
+import "cmp"
+
+// ============================================================================
+// 相等与排序
+// ============================================================================
+
+// Equal 两者都为 None，或都为 Some 且值相等
+func Equal[T comparable](a, b Option[T]) bool {
+    return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualFunc 与 Equal 相同，值用 eq 比较
+func EqualFunc[T, U any](a Option[T], b Option[U], eq func(T, U) bool) bool {
+    if a.present != b.present {
+        return false
+    }
+    return !a.present || eq(a.value, b.value)
+}
+
+// Compare 比较两个 Option，返回 -1、0 或 +1
+// None 小于任何 Some，两个 None 相等，两个 Some 按 cmp.Compare 比较值
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+    return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// CompareFunc 与 Compare 相同，值用 compare 比较
+func CompareFunc[T any](a, b Option[T], compare func(T, T) int) int {
+    switch {
+    case !a.present && !b.present:
+        return 0
+    case !a.present:
+        return -1
+    case !b.present:
+        return 1
+    default:
+        return compare(a.value, b.value)
+    }
+}
@@ -0,0 +1,24 @@
+package option
+
+import "fmt"
+
+// ============================================================================
+// 错误上下文
+// ============================================================================
+
+// Context 为 Err 的错误加上 msg 前缀，以 %w 包装，errors.Is/As 仍能匹配原错误；Ok 原样返回
+// Go 方法不能限定类型参数，因此以独立函数提供
+func Context[T any](r Result[T, error], msg string) Result[T, error] {
+    if r.ok {
+        return r
+    }
+    return Err[T](fmt.Errorf("%s: %w", msg, r.err))
+}
+
+// Contextf 与 Context 相同，前缀由 format 格式化得到，参数只在 Err 时格式化
+func Contextf[T any](r Result[T, error], format string, args ...any) Result[T, error] {
+    if r.ok {
+        return r
+    }
+    return Context(r, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,49 @@
+package option
+
+// ============================================================================
+// Do 提前返回
+// ============================================================================
+
+// DoScope Do 的作用域，只能在传给 Do 的函数内通过 Bind 使用
+type DoScope struct {
+    closed bool
+}
+
+// doAbort Bind 遇到错误时携带错误的 panic 值
+type doAbort struct {
+    scope *DoScope
+    err   error
+}
+
+// Do 模拟 Rust 的 ? 运算符：fn 内用 Bind 解包 Result，遇到 Err 时立即结束整个 fn 并返回该错误
+// 由于 Go 的函数值不能带类型参数，解包通过独立函数 Bind 完成
+//
+//	res := option.Do(func(s *option.DoScope) Config {
+//	    data := option.Bind(s, option.From(os.ReadFile(path)))
+//	    return option.Bind(s, parse(data))
+//	})
+func Do[T any](fn func(s *DoScope) T) (res Result[T, error]) {
+    s := &DoScope{}
+    defer func() {
+        s.closed = true
+        if r := recover(); r != nil {
+            abort, ok := r.(doAbort)
+            if !ok || abort.scope != s {
+                panic(r)
+            }
+            res = Err[T](abort.err)
+        }
+    }()
+    return Ok[T, error](fn(s))
+}
+
+// Bind 为 Ok 时返回值，为 Err 时结束所在的 Do 并以该错误作为结果
+func Bind[U any](s *DoScope, r Result[U, error]) U {
+    if s.closed {
+        panic("option: Bind called after its Do returned")
+    }
+    if !r.ok {
+        panic(doAbort{scope: s, err: r.err})
+    }
+    return r.value
+}
@@ -0,0 +1,108 @@
+package option
+
+import (
+    "bytes"
+    "encoding"
+    "encoding/gob"
+    "encoding/json"
+    "errors"
+    "reflect"
+)
+
+// ============================================================================
+// 文本、gob 与 YAML 编解码
+// ============================================================================
+
+// MarshalText None 编码为空文本，Some 按值的类型编码：
+// 实现 encoding.TextMarshaler 的使用自身实现，字符串类型原样输出，其余类型使用 JSON 文本
+// 注意 Some("") 与 None 的编码相同，解码后为 None
+func (o Option[T]) MarshalText() ([]byte, error) {
+    if !o.present {
+        return []byte{}, nil
+    }
+    if m, ok := any(o.value).(encoding.TextMarshaler); ok {
+        return m.MarshalText()
+    }
+    if v := reflect.ValueOf(&o.value).Elem(); v.Kind() == reflect.String {
+        return []byte(v.String()), nil
+    }
+    return json.Marshal(o.value)
+}
+
+// UnmarshalText 空文本解码为 None，其余按 MarshalText 的规则解码为 Some
+func (o *Option[T]) UnmarshalText(text []byte) error {
+    if len(text) == 0 {
+        *o = None[T]()
+        return nil
+    }
+    var v T
+    if u, ok := any(&v).(encoding.TextUnmarshaler); ok {
+        if err := u.UnmarshalText(text); err != nil {
+            return err
+        }
+    } else if rv := reflect.ValueOf(&v).Elem(); rv.Kind() == reflect.String {
+        rv.SetString(string(text))
+    } else if err := json.Unmarshal(text, &v); err != nil {
+        return err
+    }
+    *o = Some(v)
+    return nil
+}
+
+// GobEncode 第一个字节标记是否为 Some，之后是值的 gob 编码
+func (o Option[T]) GobEncode() ([]byte, error) {
+    if !o.present {
+        return []byte{0}, nil
+    }
+    var buf bytes.Buffer
+    buf.WriteByte(1)
+    if err := gob.NewEncoder(&buf).Encode(&o.value); err != nil {
+        return nil, err
+    }
+    return buf.Bytes(), nil
+}
+
+// GobDecode 解码 GobEncode 的格式
+func (o *Option[T]) GobDecode(data []byte) error {
+    if len(data) == 0 {
+        return errors.New("option: empty gob data")
+    }
+    if data[0] == 0 {
+        *o = None[T]()
+        return nil
+    }
+    var v T
+    if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&v); err != nil {
+        return err
+    }
+    *o = Some(v)
+    return nil
+}
+
+// MarshalYAML None 编码为 null，Some 编码为其值
+// 签名与 gopkg.in/yaml.v2、v3 的 Marshaler 接口一致，无需引入依赖
+func (o Option[T]) MarshalYAML() (any, error) {
+    if !o.present {
+        return nil, nil
+    }
+    return o.value, nil
+}
+
+// UnmarshalYAML null 解码为 None，其余解码为 Some
+// 使用 yaml.v2 风格的签名，yaml.v3 同样支持
+func (o *Option[T]) UnmarshalYAML(unmarshal func(any) error) error {
+    var raw any
+    if err := unmarshal(&raw); err != nil {
+        return err
+    }
+    if raw == nil {
+        *o = None[T]()
+        return nil
+    }
+    var v T
+    if err := unmarshal(&v); err != nil {
+        return err
+    }
+    *o = Some(v)
+    return nil
+}
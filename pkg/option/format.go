@@ -0,0 +1,60 @@
+package option
+
+import (
+    "fmt"
+    "log/slog"
+    "reflect"
+)
+
+// ============================================================================
+// 格式化与日志
+// ============================================================================
+
+// String 返回 Some(值) 或 None
+func (o Option[T]) String() string {
+    if o.present {
+        return fmt.Sprintf("Some(%v)", o.value)
+    }
+    return "None"
+}
+
+// GoString 返回可作为 Go 代码的表示，用于 %#v
+func (o Option[T]) GoString() string {
+    if o.present {
+        return fmt.Sprintf("option.Some[%s](%#v)", reflect.TypeFor[T](), o.value)
+    }
+    return fmt.Sprintf("option.None[%s]()", reflect.TypeFor[T]())
+}
+
+// LogValue 实现 slog.LogValuer：Some 记录为值本身，None 记录为字符串 "None"
+func (o Option[T]) LogValue() slog.Value {
+    if o.present {
+        return slog.AnyValue(o.value)
+    }
+    return slog.StringValue("None")
+}
+
+// String 返回 Ok(值) 或 Err(错误)
+func (r Result[T, E]) String() string {
+    if r.ok {
+        return fmt.Sprintf("Ok(%v)", r.value)
+    }
+    return fmt.Sprintf("Err(%v)", r.err)
+}
+
+// GoString 返回可作为 Go 代码的表示，用于 %#v
+func (r Result[T, E]) GoString() string {
+    typeArgs := fmt.Sprintf("%s, %s", reflect.TypeFor[T](), reflect.TypeFor[E]())
+    if r.ok {
+        return fmt.Sprintf("option.Ok[%s](%#v)", typeArgs, r.value)
+    }
+    return fmt.Sprintf("option.Err[%s](%#v)", typeArgs, r.err)
+}
+
+// LogValue 实现 slog.LogValuer：Ok 记录为 ok 字段，Err 记录为 err 字段
+func (r Result[T, E]) LogValue() slog.Value {
+    if r.ok {
+        return slog.GroupValue(slog.Any("ok", r.value))
+    }
+    return slog.GroupValue(slog.Any("err", r.err))
+}
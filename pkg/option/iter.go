@@ -0,0 +1,33 @@
+package option
+
+import "iter"
+
+// ============================================================================
+// 迭代器
+// ============================================================================
+
+// Iter 返回 Some 时产生一个值、None 时不产生值的迭代器
+func (o Option[T]) Iter() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        if o.present {
+            yield(o.value)
+        }
+    }
+}
+
+// Iter 返回 Ok 时产生一个值、Err 时不产生值的迭代器
+func (r Result[T, E]) Iter() iter.Seq[T] {
+    return func(yield func(T) bool) {
+        if r.ok {
+            yield(r.value)
+        }
+    }
+}
+
+// FromSeq 返回 seq 的第一个元素，seq 为空时返回 None
+func FromSeq[T any](seq iter.Seq[T]) Option[T] {
+    for v := range seq {
+        return Some(v)
+    }
+    return None[T]()
+}
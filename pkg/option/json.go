@@ -0,0 +1,110 @@
+package option
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "fmt"
+)
+
+// ============================================================================
+// JSON 编解码
+// ============================================================================
+
+var jsonNull = []byte("null")
+
+// MarshalJSON None 编码为 null，Some 编码为其值
+// 结构体字段可以使用 `json:",omitzero"` 在 None 时省略该字段
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+    if !o.present {
+        return jsonNull, nil
+    }
+    return json.Marshal(o.value)
+}
+
+// UnmarshalJSON null 解码为 None，其余解码为 Some
+// 注意 Some(nil 指针) 编码为 null，解码后变为 None
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+    if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+        *o = None[T]()
+        return nil
+    }
+    var v T
+    if err := json.Unmarshal(data, &v); err != nil {
+        return err
+    }
+    *o = Some(v)
+    return nil
+}
+
+// resultJSON Result 的编码格式：Ok 为 {"ok": 值}，Err 为 {"err": 错误}
+type resultJSON struct {
+    Ok  json.RawMessage `json:"ok,omitempty"`
+    Err json.RawMessage `json:"err,omitempty"`
+}
+
+// MarshalJSON Ok 编码为 {"ok": 值}，Err 编码为 {"err": 错误}
+// E 为 error 且没有实现 json.Marshaler 时编码为错误信息字符串
+func (r Result[T, E]) MarshalJSON() ([]byte, error) {
+    if r.ok {
+        v, err := json.Marshal(r.value)
+        if err != nil {
+            return nil, err
+        }
+        return json.Marshal(resultJSON{Ok: v})
+    }
+
+    var (
+        e   []byte
+        err error
+    )
+    if ev, isErr := any(r.err).(error); isErr && !isJSONMarshaler(ev) {
+        e, err = json.Marshal(ev.Error())
+    } else {
+        e, err = json.Marshal(r.err)
+    }
+    if err != nil {
+        return nil, err
+    }
+    return json.Marshal(resultJSON{Err: e})
+}
+
+// UnmarshalJSON 解码 MarshalJSON 的格式，E 为 error 接口时错误信息解码为 errors.New
+func (r *Result[T, E]) UnmarshalJSON(data []byte) error {
+    var raw resultJSON
+    if err := json.Unmarshal(data, &raw); err != nil {
+        return err
+    }
+
+    switch {
+    case raw.Ok != nil && raw.Err != nil:
+        return errors.New(`option: result JSON has both "ok" and "err"`)
+    case raw.Ok != nil:
+        var v T
+        if err := json.Unmarshal(raw.Ok, &v); err != nil {
+            return err
+        }
+        *r = Ok[T, E](v)
+        return nil
+    case raw.Err != nil:
+        var e E
+        if ep, isErr := any(&e).(*error); isErr {
+            var msg string
+            if err := json.Unmarshal(raw.Err, &msg); err != nil {
+                return err
+            }
+            *ep = errors.New(msg)
+        } else if err := json.Unmarshal(raw.Err, &e); err != nil {
+            return err
+        }
+        *r = Err[T](e)
+        return nil
+    default:
+        return fmt.Errorf(`option: result JSON needs "ok" or "err": %s`, data)
+    }
+}
+
+func isJSONMarshaler(v any) bool {
+    _, ok := v.(json.Marshaler)
+    return ok
+}
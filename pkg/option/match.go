@@ -0,0 +1,114 @@
+package option
+
+// ============================================================================
+// 模式匹配构建器
+// ============================================================================
+
+// OptionMatcher Option 的模式匹配构建器，按添加顺序匹配，第一个匹配的分支生效
+type OptionMatcher[U, T any] struct {
+    opt     Option[T]
+    result  U
+    matched bool
+}
+
+// Match 开始对 o 的模式匹配，U 为结果类型
+//
+//	label := option.Match[string](age).
+//	    SomeWhere(func(a int) bool { return a >= 18 }, func(int) string { return "adult" }).
+//	    Some(func(int) string { return "minor" }).
+//	    None(func() string { return "unknown" }).
+//	    Eval()
+func Match[U, T any](o Option[T]) *OptionMatcher[U, T] {
+    return &OptionMatcher[U, T]{opt: o}
+}
+
+// SomeWhere 为 Some 且值满足 guard 时使用 f
+func (m *OptionMatcher[U, T]) SomeWhere(guard func(T) bool, f func(T) U) *OptionMatcher[U, T] {
+    if !m.matched && m.opt.present && guard(m.opt.value) {
+        m.result, m.matched = f(m.opt.value), true
+    }
+    return m
+}
+
+// Some 为 Some 时使用 f
+func (m *OptionMatcher[U, T]) Some(f func(T) U) *OptionMatcher[U, T] {
+    return m.SomeWhere(func(T) bool { return true }, f)
+}
+
+// None 为 None 时使用 f
+func (m *OptionMatcher[U, T]) None(f func() U) *OptionMatcher[U, T] {
+    if !m.matched && !m.opt.present {
+        m.result, m.matched = f(), true
+    }
+    return m
+}
+
+// Eval 返回匹配分支的结果，没有分支匹配时 panic
+func (m *OptionMatcher[U, T]) Eval() U {
+    if !m.matched {
+        panic("option: no arm matched in Match")
+    }
+    return m.result
+}
+
+// EvalOr 返回匹配分支的结果，没有分支匹配时返回 defaultValue
+func (m *OptionMatcher[U, T]) EvalOr(defaultValue U) U {
+    if !m.matched {
+        return defaultValue
+    }
+    return m.result
+}
+
+// ResultMatcher Result 的模式匹配构建器，按添加顺序匹配，第一个匹配的分支生效
+type ResultMatcher[U, T, E any] struct {
+    res     Result[T, E]
+    result  U
+    matched bool
+}
+
+// MatchR 开始对 r 的模式匹配，U 为结果类型
+func MatchR[U, T, E any](r Result[T, E]) *ResultMatcher[U, T, E] {
+    return &ResultMatcher[U, T, E]{res: r}
+}
+
+// OkWhere 为 Ok 且值满足 guard 时使用 f
+func (m *ResultMatcher[U, T, E]) OkWhere(guard func(T) bool, f func(T) U) *ResultMatcher[U, T, E] {
+    if !m.matched && m.res.ok && guard(m.res.value) {
+        m.result, m.matched = f(m.res.value), true
+    }
+    return m
+}
+
+// Ok 为 Ok 时使用 f
+func (m *ResultMatcher[U, T, E]) Ok(f func(T) U) *ResultMatcher[U, T, E] {
+    return m.OkWhere(func(T) bool { return true }, f)
+}
+
+// ErrWhere 为 Err 且错误满足 guard 时使用 f
+func (m *ResultMatcher[U, T, E]) ErrWhere(guard func(E) bool, f func(E) U) *ResultMatcher[U, T, E] {
+    if !m.matched && !m.res.ok && guard(m.res.err) {
+        m.result, m.matched = f(m.res.err), true
+    }
+    return m
+}
+
+// Err 为 Err 时使用 f
+func (m *ResultMatcher[U, T, E]) Err(f func(E) U) *ResultMatcher[U, T, E] {
+    return m.ErrWhere(func(E) bool { return true }, f)
+}
+
+// Eval 返回匹配分支的结果，没有分支匹配时 panic
+func (m *ResultMatcher[U, T, E]) Eval() U {
+    if !m.matched {
+        panic("option: no arm matched in MatchR")
+    }
+    return m.result
+}
+
+// EvalOr 返回匹配分支的结果，没有分支匹配时返回 defaultValue
+func (m *ResultMatcher[U, T, E]) EvalOr(defaultValue U) U {
+    if !m.matched {
+        return defaultValue
+    }
+    return m.result
+}
@@ -1,5 +1,7 @@
 package option
 
+import "github.com/hunter-hongg/GoPlus/pkg/defaults"
+
 // Option 表示一个可选值，类似于Rust的Option
 type Option[T any] struct {
     value   T
@@ -31,6 +33,23 @@ func (o Option[T]) UnwrapOrElse(f func() T) T {
     return f()
 }
 
+// UnwrapOrZero 为 None 时返回 T 的零值
+func (o Option[T]) UnwrapOrZero() T {
+    if o.present {
+        return o.value
+    }
+    var zero T
+    return zero
+}
+
+// UnwrapOrDefault 为 None 时返回 T 的默认值（见 defaults.Value：注册的提供函数、Defaulter 实现或零值）
+func (o Option[T]) UnwrapOrDefault() T {
+    if o.present {
+        return o.value
+    }
+    return defaults.Value[T]()
+}
+
 func (o Option[T]) Expect(msg string) T {
     if !o.present {
         panic(msg)
@@ -38,6 +57,62 @@ func (o Option[T]) Expect(msg string) T {
     return o.value
 }
 
+// IsSomeAnd 为 Some 且值满足 f
+func (o Option[T]) IsSomeAnd(f func(T) bool) bool {
+    return o.present && f(o.value)
+}
+
+// IsNoneOr 为 None 或值满足 f
+func (o Option[T]) IsNoneOr(f func(T) bool) bool {
+    return !o.present || f(o.value)
+}
+
+// 原地修改（指针接收者）
+
+// Take 取出值并把 o 置为 None
+func (o *Option[T]) Take() Option[T] {
+    old := *o
+    *o = None[T]()
+    return old
+}
+
+// Replace 把 o 置为 Some(value)，返回原来的值
+func (o *Option[T]) Replace(value T) Option[T] {
+    old := *o
+    *o = Some(value)
+    return old
+}
+
+// Insert 把 o 置为 Some(value)，返回指向新值的指针
+func (o *Option[T]) Insert(value T) *T {
+    *o = Some(value)
+    return &o.value
+}
+
+// GetOrInsert o 为 None 时置为 Some(value)，返回指向值的指针
+func (o *Option[T]) GetOrInsert(value T) *T {
+    if !o.present {
+        *o = Some(value)
+    }
+    return &o.value
+}
+
+// GetOrInsertWith o 为 None 时置为 Some(f())，返回指向值的指针
+func (o *Option[T]) GetOrInsertWith(f func() T) *T {
+    if !o.present {
+        *o = Some(f())
+    }
+    return &o.value
+}
+
+// Inspect 为 Some 时以值调用 f，原样返回 o
+func (o Option[T]) Inspect(f func(T)) Option[T] {
+    if o.present {
+        f(o.value)
+    }
+    return o
+}
+
 // 独立函数版本的各种操作
 
 // Map 将 Option[T] 转换为 Option[U]
@@ -107,6 +182,23 @@ func Filter[T any](opt Option[T], predicate func(T) bool) Option[T] {
     return Option[T]{present: false}
 }
 
+// Contains 是否为 Some 且值等于 value
+func Contains[T comparable](opt Option[T], value T) bool {
+    return opt.present && opt.value == value
+}
+
+// Xor 恰好一个为 Some 时返回它，否则返回 None
+func Xor[T any](a Option[T], b Option[T]) Option[T] {
+    switch {
+    case a.present && !b.present:
+        return a
+    case !a.present && b.present:
+        return b
+    default:
+        return Option[T]{present: false}
+    }
+}
+
 // ToResult 将 Option 转换为 Result
 func ToResult[T, E any](opt Option[T], err E) Result[T, E] {
     if opt.present {
@@ -121,6 +213,24 @@ func ToResult[T, E any](opt Option[T], err E) Result[T, E] {
     }
 }
 
+// OkOr 为 Some 时返回 Ok(值)，否则返回 Err(err)；其他错误类型使用 ToResult
+func (o Option[T]) OkOr(err error) Result[T, error] {
+    return ToResult(o, err)
+}
+
+// OkOrElse 与 OkOr 相同，但错误仅在 None 时由 f 构造；其他错误类型使用 ToResultElse
+func (o Option[T]) OkOrElse(f func() error) Result[T, error] {
+    return ToResultElse(o, f)
+}
+
+// ToResultElse 将 Option 转换为 Result，错误仅在 None 时由 f 构造
+func ToResultElse[T, E any](opt Option[T], f func() E) Result[T, E] {
+    if opt.present {
+        return Ok[T, E](opt.value)
+    }
+    return Err[T](f())
+}
+
 // 创建函数
 func Some[T any](value T) Option[T] {
     return Option[T]{
@@ -169,6 +279,23 @@ func (r Result[T, E]) UnwrapOrElse(f func(E) T) T {
     return f(r.err)
 }
 
+// UnwrapOrZero 为 Err 时返回 T 的零值
+func (r Result[T, E]) UnwrapOrZero() T {
+    if r.ok {
+        return r.value
+    }
+    var zero T
+    return zero
+}
+
+// UnwrapOrDefault 为 Err 时返回 T 的默认值（见 defaults.Value）
+func (r Result[T, E]) UnwrapOrDefault() T {
+    if r.ok {
+        return r.value
+    }
+    return defaults.Value[T]()
+}
+
 func (r Result[T, E]) Expect(msg string) T {
     if !r.ok {
         panic(msg)
@@ -183,6 +310,11 @@ func (r Result[T, E]) UnwrapErr() E {
     return r.err
 }
 
+// Into 拆回 Go 惯用的 (值, 错误) 形式：Ok 时错误为零值（E 为 error 时即 nil），Err 时值为零值
+func (r Result[T, E]) Into() (T, E) {
+    return r.value, r.err
+}
+
 // 独立函数版本的各种操作
 
 // MapResult 将 Result[T, E] 转换为 Result[U, E]
@@ -258,6 +390,16 @@ func Err[T any, E any](err E) Result[T, E] {
     }
 }
 
+// From 把 Go 惯用的 (值, 错误) 返回转换为 Result，err 非 nil 时为 Err
+//
+//	f := option.From(os.Open(path))
+func From[T any](value T, err error) Result[T, error] {
+    if err != nil {
+        return Err[T](err)
+    }
+    return Ok[T, error](value)
+}
+
 // ============================================================================
 // 工具函数
 // ============================================================================
@@ -298,6 +440,44 @@ func Zip[T, U any](a Option[T], b Option[U]) Option[struct {
     }]()
 }
 
+// ZipWith 两个都是 Some 时返回 Some(f(a, b))，否则返回 None
+func ZipWith[T, U, R any](a Option[T], b Option[U], f func(T, U) R) Option[R] {
+    if a.present && b.present {
+        return Some(f(a.value, b.value))
+    }
+    return None[R]()
+}
+
+// Lift 将普通函数转换为作用于 Option 的函数
+func Lift[T, U any](f func(T) U) func(Option[T]) Option[U] {
+    return func(opt Option[T]) Option[U] {
+        return Map(opt, f)
+    }
+}
+
+// Lift2 将二元函数转换为作用于 Option 的函数，任一参数为 None 时返回 None
+func Lift2[T, U, R any](f func(T, U) R) func(Option[T], Option[U]) Option[R] {
+    return func(a Option[T], b Option[U]) Option[R] {
+        return ZipWith(a, b, f)
+    }
+}
+
+// Apply 函数和参数都是 Some 时返回 Some(f(v))，否则返回 None
+func Apply[T, U any](f Option[func(T) U], v Option[T]) Option[U] {
+    if f.present && v.present {
+        return Some(f.value(v.value))
+    }
+    return None[U]()
+}
+
+// ApplyResult 函数和参数都是 Ok 时返回 Ok(f(v))，否则返回第一个 Err（先检查函数）
+func ApplyResult[T, U, E any](f Result[func(T) U, E], v Result[T, E]) Result[U, E] {
+    if !f.ok {
+        return Err[U](f.err)
+    }
+    return MapResult(v, f.value)
+}
+
 // Unzip 将 Option 对分解为两个 Option
 func Unzip[T, U any](opt Option[struct {
     First  T
@@ -340,3 +520,11 @@ func Flatten[T any](opt Option[Option[T]]) Option[T] {
     }
     return None[T]()
 }
+
+// FlattenResult 将 Result[Result[T, E], E] 转换为 Result[T, E]
+func FlattenResult[T, E any](res Result[Result[T, E], E]) Result[T, E] {
+    if res.ok {
+        return res.value
+    }
+    return Err[T](res.err)
+}
@@ -0,0 +1,60 @@
+// Package optionpb 在 Option 与 protobuf 的 well-known types 之间转换。
+//
+// 包内只依赖这些类型的方法集（GetValue、AsTime、AsDuration），不直接引入
+// google.golang.org/protobuf，因此使用方已有的任意版本都能配合使用：
+//
+//	name := optionpb.FromWrapper[string](req.Name)        // *wrapperspb.StringValue
+//	resp.Limit = optionpb.ToWrapper(limit, wrapperspb.Int64) // nil 表示未设置
+//	deadline := optionpb.FromTimestamp(req.Deadline)       // *timestamppb.Timestamp
+//	resp.Expires = optionpb.ToWrapper(expires, timestamppb.New)
+//
+// proto3 optional 标量字段生成为指针，直接使用 option.FromPtr 与 option.ToPtr。
+package optionpb
+
+import (
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// FromWrapper 把 wrapperspb 包装类型（*StringValue、*Int64Value 等）转换为 Option，nil 为 None
+// T 为包装的值类型，需要显式指定：FromWrapper[string](w)
+func FromWrapper[T any, M any, W interface {
+	*M
+	GetValue() T
+}](w W) option.Option[T] {
+	if w == nil {
+		return option.None[T]()
+	}
+	return option.Some(w.GetValue())
+}
+
+// ToWrapper 用 wrap（如 wrapperspb.String、timestamppb.New）把 Some 转换为消息，None 返回 nil
+func ToWrapper[T any, M any](o option.Option[T], wrap func(T) *M) *M {
+	if o.IsNone() {
+		return nil
+	}
+	return wrap(o.Unwrap())
+}
+
+// FromTimestamp 把 *timestamppb.Timestamp 转换为 Option[time.Time]，nil 为 None
+func FromTimestamp[M any, W interface {
+	*M
+	AsTime() time.Time
+}](ts W) option.Option[time.Time] {
+	if ts == nil {
+		return option.None[time.Time]()
+	}
+	return option.Some(ts.AsTime())
+}
+
+// FromDuration 把 *durationpb.Duration 转换为 Option[time.Duration]，nil 为 None
+func FromDuration[M any, W interface {
+	*M
+	AsDuration() time.Duration
+}](d W) option.Option[time.Duration] {
+	if d == nil {
+		return option.None[time.Duration]()
+	}
+	return option.Some(d.AsDuration())
+}
@@ -0,0 +1,108 @@
+// Package parse 把常见的字符串解析包装为 option.Result，需要 Option 时调用 ToOption()。
+//
+//	port := parse.Int(q.Get("port")).UnwrapOr(8080)
+//	deadline := parse.Time(time.RFC3339, s).ToOption()
+package parse
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// ErrInvalidUUID UUID 格式不正确
+var ErrInvalidUUID = errors.New("parse: invalid UUID")
+
+// Int 按十进制解析 int
+func Int(s string) option.Result[int, error] {
+	return option.From(strconv.Atoi(strings.TrimSpace(s)))
+}
+
+// Int64 按 base 进制解析 int64，base 为 0 时根据前缀判断
+func Int64(s string, base int) option.Result[int64, error] {
+	return option.From(strconv.ParseInt(strings.TrimSpace(s), base, 64))
+}
+
+// Uint64 按 base 进制解析 uint64，base 为 0 时根据前缀判断
+func Uint64(s string, base int) option.Result[uint64, error] {
+	return option.From(strconv.ParseUint(strings.TrimSpace(s), base, 64))
+}
+
+// Float 解析 float64
+func Float(s string) option.Result[float64, error] {
+	return option.From(strconv.ParseFloat(strings.TrimSpace(s), 64))
+}
+
+// Bool 解析 bool，接受 strconv.ParseBool 支持的写法
+func Bool(s string) option.Result[bool, error] {
+	return option.From(strconv.ParseBool(strings.TrimSpace(s)))
+}
+
+// Duration 解析 time.Duration，例如 "1m30s"
+func Duration(s string) option.Result[time.Duration, error] {
+	return option.From(time.ParseDuration(strings.TrimSpace(s)))
+}
+
+// Time 按 layout 解析时间
+func Time(layout, s string) option.Result[time.Time, error] {
+	return option.From(time.Parse(layout, strings.TrimSpace(s)))
+}
+
+// URL 解析绝对 URL，缺少 scheme 或 host 时返回错误
+func URL(s string) option.Result[*url.URL, error] {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return option.Err[*url.URL](err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return option.Err[*url.URL](fmt.Errorf("parse: URL %q is not absolute", s))
+	}
+	return option.Ok[*url.URL, error](u)
+}
+
+// UUIDValue 16 字节的 UUID
+type UUIDValue [16]byte
+
+// String 返回 xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx 形式的小写表示
+func (u UUIDValue) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// UUID 解析带连字符的标准形式，也接受 urn:uuid: 前缀、花括号和不带连字符的 32 位十六进制
+func UUID(s string) option.Result[UUIDValue, error] {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(strings.ToLower(s), "urn:uuid:")
+	if len(s) == 38 && s[0] == '{' && s[37] == '}' {
+		s = s[1:37]
+	}
+	if len(s) == 36 {
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return option.Err[UUIDValue](ErrInvalidUUID)
+		}
+		s = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	}
+	var u UUIDValue
+	if len(s) != 32 {
+		return option.Err[UUIDValue](ErrInvalidUUID)
+	}
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return option.Err[UUIDValue](ErrInvalidUUID)
+	}
+	return option.Ok[UUIDValue, error](u)
+}
@@ -0,0 +1,60 @@
+// Package res 提供错误类型固定为 error 的 Result 简写及其辅助函数。
+//
+//	func load(path string) res.R[[]byte] {
+//		return res.Wrap(option.From(os.ReadFile(path)), "load config")
+//	}
+package res
+
+import (
+	"errors"
+
+	"github.com/hunter-hongg/GoPlus/pkg/errorsx"
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// R 错误类型为 error 的 Result
+type R[T any] = option.Result[T, error]
+
+// OkR 创建 Ok 结果
+func OkR[T any](value T) R[T] {
+	return option.Ok[T, error](value)
+}
+
+// ErrR 创建 Err 结果
+func ErrR[T any](err error) R[T] {
+	return option.Err[T](err)
+}
+
+// Wrap 为 Err 的错误加上 msg 前缀（保留 %w 链），Ok 原样返回
+func Wrap[T any](r R[T], msg string) R[T] {
+	return option.Context(r, msg)
+}
+
+// Wrapf 与 Wrap 相同，前缀由 format 格式化得到
+func Wrapf[T any](r R[T], format string, args ...any) R[T] {
+	return option.Contextf(r, format, args...)
+}
+
+// Is 报告 r 是否为 Err 且 errors.Is(错误, target)
+func Is[T any](r R[T], target error) bool {
+	return r.IsErr() && errors.Is(r.UnwrapErr(), target)
+}
+
+// As 报告 r 是否为 Err 且 errors.As(错误, target)
+func As[T any](r R[T], target any) bool {
+	return r.IsErr() && errors.As(r.UnwrapErr(), target)
+}
+
+// CollectInto 将 rs 中的错误按顺序收集到 m，返回所有成功的值
+func CollectInto[T any](m *errorsx.Multi, rs ...R[T]) []T {
+	values := make([]T, 0, len(rs))
+	for _, r := range rs {
+		v, err := r.Into()
+		if err != nil {
+			m.Add(err)
+			continue
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,50 @@
+package option
+
+import (
+    "fmt"
+
+    "github.com/hunter-hongg/GoPlus/pkg/errorsx"
+)
+
+// ============================================================================
+// panic 与错误的转换
+// ============================================================================
+
+// Try 运行 fn，返回 Ok(结果)；fn panic 时返回 Err(*errorsx.PanicError)
+func Try[T any](fn func() T) Result[T, error] {
+    return TryE(func() (T, error) { return fn(), nil })
+}
+
+// TryE 运行 fn 并转换为 Result；fn 返回错误或 panic（*errorsx.PanicError）时返回 Err
+// panic 产生的错误满足 errors.Is(err, errorsx.ErrPanic)
+func TryE[T any](fn func() (T, error)) Result[T, error] {
+    var (
+        v   T
+        err error
+    )
+    func() {
+        defer errorsx.Recover(&err)
+        v, err = fn()
+    }()
+    if err != nil {
+        return Err[T](err)
+    }
+    return Ok[T, error](v)
+}
+
+// Must 返回 value，err 非 nil 时 panic；用于程序初始化（编译正则、解析模板等）
+// panic 的值是包装了 err 的 error，可由 Try 恢复后通过 errors.Is/As 检查
+func Must[T any](value T, err error) T {
+    if err != nil {
+        panic(fmt.Errorf("option: Must: %w", err))
+    }
+    return value
+}
+
+// Must2 与 Must 相同，用于返回两个值的函数
+func Must2[T, U any](a T, b U, err error) (T, U) {
+    if err != nil {
+        panic(fmt.Errorf("option: Must2: %w", err))
+    }
+    return a, b
+}
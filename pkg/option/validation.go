@@ -0,0 +1,102 @@
+package option
+
+// ============================================================================
+// Validation 累积错误的校验结果
+// ============================================================================
+
+// Validation 与 Result 类似，但组合多个 Validation 时会收集全部错误而不是在第一个错误处停止，
+// 适合校验表单、DTO 等需要一次性报告所有问题的场景
+type Validation[T any, E any] struct {
+    value T
+    errs  []E
+}
+
+// Valid 创建校验通过的 Validation
+func Valid[T any, E any](value T) Validation[T, E] {
+    return Validation[T, E]{value: value}
+}
+
+// Invalid 创建校验失败的 Validation，errs 为空时视为通过（值为零值）
+func Invalid[T any, E any](errs ...E) Validation[T, E] {
+    return Validation[T, E]{errs: errs}
+}
+
+// Check 依次用 checks 校验 value，收集所有返回 Some 的错误
+func Check[T any, E any](value T, checks ...func(T) Option[E]) Validation[T, E] {
+    var errs []E
+    for _, check := range checks {
+        if e := check(value); e.present {
+            errs = append(errs, e.value)
+        }
+    }
+    return Validation[T, E]{value: value, errs: errs}
+}
+
+// FromResultValidation 将 Result 转换为 Validation
+func FromResultValidation[T any, E any](res Result[T, E]) Validation[T, E] {
+    if res.ok {
+        return Valid[T, E](res.value)
+    }
+    return Invalid[T](res.err)
+}
+
+func (v Validation[T, E]) IsValid() bool { return len(v.errs) == 0 }
+
+// Errors 返回收集到的错误，通过时为 nil
+func (v Validation[T, E]) Errors() []E { return v.errs }
+
+// ToResult 通过时返回 Ok(值)，否则返回 Err(全部错误)
+func (v Validation[T, E]) ToResult() Result[T, []E] {
+    if len(v.errs) == 0 {
+        return Ok[T, []E](v.value)
+    }
+    return Err[T](v.errs)
+}
+
+// ApplyValidation 把 vf 中的函数应用到 va 的值上，两者的错误都会被收集
+func ApplyValidation[T, U, E any](vf Validation[func(T) U, E], va Validation[T, E]) Validation[U, E] {
+    if errs := joinErrs(vf.errs, va.errs); len(errs) > 0 {
+        return Invalid[U](errs...)
+    }
+    return Valid[U, E](vf.value(va.value))
+}
+
+// Combine 全部通过时以 f 组合两个值，否则收集两者的全部错误
+func Combine[A, B, R, E any](a Validation[A, E], b Validation[B, E], f func(A, B) R) Validation[R, E] {
+    if errs := joinErrs(a.errs, b.errs); len(errs) > 0 {
+        return Invalid[R](errs...)
+    }
+    return Valid[R, E](f(a.value, b.value))
+}
+
+// Combine3 全部通过时以 f 组合三个值，否则收集全部错误
+func Combine3[A, B, C, R, E any](a Validation[A, E], b Validation[B, E], c Validation[C, E], f func(A, B, C) R) Validation[R, E] {
+    if errs := joinErrs(a.errs, b.errs, c.errs); len(errs) > 0 {
+        return Invalid[R](errs...)
+    }
+    return Valid[R, E](f(a.value, b.value, c.value))
+}
+
+// CombineAll 全部通过时按顺序收集值，否则收集全部错误
+func CombineAll[T, E any](vs []Validation[T, E]) Validation[[]T, E] {
+    var (
+        values = make([]T, 0, len(vs))
+        errs   []E
+    )
+    for _, v := range vs {
+        values = append(values, v.value)
+        errs = append(errs, v.errs...)
+    }
+    if len(errs) > 0 {
+        return Invalid[[]T](errs...)
+    }
+    return Valid[[]T, E](values)
+}
+
+func joinErrs[E any](groups ...[]E) []E {
+    var errs []E
+    for _, g := range groups {
+        errs = append(errs, g...)
+    }
+    return errs
+}
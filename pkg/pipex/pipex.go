@@ -0,0 +1,159 @@
+package pipex
+
+import (
+	"context"
+	"io"
+	"iter"
+	"sync"
+)
+
+// pipe Reader 与 Writer 共享的状态
+type pipe[T any] struct {
+	ch chan T
+
+	rOnce sync.Once
+	rDone chan struct{}
+	rErr  error
+
+	wOnce sync.Once
+	wDone chan struct{}
+	wErr  error
+}
+
+// Reader 类型化管道的读端
+type Reader[T any] struct {
+	p *pipe[T]
+}
+
+// Writer 类型化管道的写端
+type Writer[T any] struct {
+	p *pipe[T]
+}
+
+// Pipe 创建相连的读端和写端，类似 io.Pipe 但传递类型化的值
+// buffer 为 0 时为同步（会合）模式：Write 在值被 Read 取走后才返回；
+// buffer > 0 时写端最多可以领先读端 buffer 个值，满了之后 Write 阻塞形成背压。
+func Pipe[T any](buffer int) (*Reader[T], *Writer[T]) {
+	p := &pipe[T]{
+		ch:    make(chan T, buffer),
+		rDone: make(chan struct{}),
+		wDone: make(chan struct{}),
+	}
+	return &Reader[T]{p: p}, &Writer[T]{p: p}
+}
+
+// ==================== 读端 ====================
+
+// Read 读取下一个值
+// 写端关闭且缓冲区读空后返回写端的关闭错误（默认 io.EOF）；
+// 读端已关闭时返回 io.ErrClosedPipe
+func (r *Reader[T]) Read() (T, error) {
+	return r.ReadCtx(context.Background())
+}
+
+// ReadCtx 读取下一个值，ctx 结束时返回 ctx.Err()
+func (r *Reader[T]) ReadCtx(ctx context.Context) (T, error) {
+	p := r.p
+	var zero T
+	select {
+	case <-p.rDone:
+		return zero, io.ErrClosedPipe
+	default:
+	}
+
+	select {
+	case v := <-p.ch:
+		return v, nil
+	case <-p.wDone:
+		// 写端关闭后先读完缓冲区中剩余的值
+		select {
+		case v := <-p.ch:
+			return v, nil
+		default:
+			return zero, p.wErr
+		}
+	case <-p.rDone:
+		return zero, io.ErrClosedPipe
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// All 返回逐个读取值的迭代器，读到任何错误（包括 io.EOF）时结束，需要区分错误时请直接调用 Read
+func (r *Reader[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, err := r.Read()
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Close 关闭读端，之后的 Write 返回 io.ErrClosedPipe
+func (r *Reader[T]) Close() error {
+	return r.CloseWithError(nil)
+}
+
+// CloseWithError 关闭读端，之后的 Write 返回 err（nil 时为 io.ErrClosedPipe）
+func (r *Reader[T]) CloseWithError(err error) error {
+	if err == nil {
+		err = io.ErrClosedPipe
+	}
+	p := r.p
+	p.rOnce.Do(func() {
+		p.rErr = err
+		close(p.rDone)
+	})
+	return nil
+}
+
+// ==================== 写端 ====================
+
+// Write 写入一个值，缓冲区满（或会合模式下没有读者）时阻塞
+// 读端关闭时返回读端的关闭错误；写端已关闭时返回 io.ErrClosedPipe
+func (w *Writer[T]) Write(v T) error {
+	return w.WriteCtx(context.Background(), v)
+}
+
+// WriteCtx 写入一个值，ctx 结束时返回 ctx.Err()
+func (w *Writer[T]) WriteCtx(ctx context.Context, v T) error {
+	p := w.p
+	select {
+	case <-p.wDone:
+		return io.ErrClosedPipe
+	case <-p.rDone:
+		return p.rErr
+	default:
+	}
+
+	select {
+	case p.ch <- v:
+		return nil
+	case <-p.rDone:
+		return p.rErr
+	case <-p.wDone:
+		return io.ErrClosedPipe
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close 关闭写端，读端读完剩余值后收到 io.EOF
+func (w *Writer[T]) Close() error {
+	return w.CloseWithError(nil)
+}
+
+// CloseWithError 关闭写端，读端读完剩余值后收到 err（nil 时为 io.EOF）
+func (w *Writer[T]) CloseWithError(err error) error {
+	if err == nil {
+		err = io.EOF
+	}
+	p := w.p
+	p.wOnce.Do(func() {
+		p.wErr = err
+		close(p.wDone)
+	})
+	return nil
+}
@@ -0,0 +1,134 @@
+package rx
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+)
+
+// ErrEmpty Observable 在产生任何值之前完成
+var ErrEmpty = errors.New("rx: observable completed without values")
+
+// ==================== 来源 ====================
+
+// Just 依次推送 vs 后完成
+func Just[T any](vs ...T) Observable[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for _, v := range vs {
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// FromSeq 推送迭代器中的值
+func FromSeq[T any](seq iter.Seq[T]) Observable[T] {
+	return Create(func(ctx context.Context, emit func(T) bool) error {
+		for v := range seq {
+			if ctx.Err() != nil || !emit(v) {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+// FromChan 推送通道中的值，通道关闭时完成
+// 通道被多个订阅者共享，每个值只会推送给其中一个订阅者
+func FromChan[T any](ch <-chan T) Observable[T] {
+	return Create(func(ctx context.Context, emit func(T) bool) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case v, ok := <-ch:
+				if !ok || !emit(v) {
+					return nil
+				}
+			}
+		}
+	})
+}
+
+// FromFuture 在 f 完成后推送其结果，f 出错时以该错误终止
+func FromFuture[T any](f future.Future[T]) Observable[T] {
+	return Create(func(ctx context.Context, emit func(T) bool) error {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-f.Done():
+		}
+		if err := f.Error(); err != nil {
+			return err
+		}
+		emit(f.Get())
+		return nil
+	})
+}
+
+// ==================== 转换为通道与 Future ====================
+
+// ToChan 把 o 的值发送到返回的通道，o 终止或 ctx 结束时关闭通道
+// 终止错误会被忽略，需要错误时使用 Subscribe 或 ToSlice
+func ToChan[T any](ctx context.Context, o Observable[T]) <-chan T {
+	out := make(chan T)
+	wait := o.subscribe(ctx, func(v T) bool {
+		select {
+		case out <- v:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	go func() {
+		defer close(out)
+		wait()
+	}()
+	return out
+}
+
+// First 返回 o 的第一个值，o 在产生值之前完成时返回 ErrEmpty
+func First[T any](ctx context.Context, o Observable[T]) future.Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	var (
+		first T
+		found bool
+	)
+	wait := o.subscribe(ctx, func(v T) bool {
+		first, found = v, true
+		return false
+	})
+	return future.NewWithContextE(ctx, func() (T, error) {
+		defer cancel()
+		err := wait()
+		if found {
+			return first, nil
+		}
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err == nil {
+			err = ErrEmpty
+		}
+		return first, err
+	})
+}
+
+// ToSlice 收集 o 的所有值，o 完成后得到结果
+func ToSlice[T any](ctx context.Context, o Observable[T]) future.Future[[]T] {
+	var out []T
+	wait := o.subscribe(ctx, func(v T) bool {
+		out = append(out, v)
+		return ctx.Err() == nil
+	})
+	return future.NewWithContextE(ctx, func() ([]T, error) {
+		err := wait()
+		if err == nil {
+			err = ctx.Err()
+		}
+		return out, err
+	})
+}
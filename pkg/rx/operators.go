@@ -0,0 +1,195 @@
+package rx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ==================== 转换与过滤 ====================
+
+// Map 对每个值应用 fn
+func Map[T, U any](o Observable[T], fn func(T) U) Observable[U] {
+	return Observable[U]{subscribe: func(ctx context.Context, emit func(U) bool) func() error {
+		return o.subscribe(ctx, func(v T) bool { return emit(fn(v)) })
+	}}
+}
+
+// Filter 只保留满足 pred 的值
+func (o Observable[T]) Filter(pred func(T) bool) Observable[T] {
+	return Observable[T]{subscribe: func(ctx context.Context, emit func(T) bool) func() error {
+		return o.subscribe(ctx, func(v T) bool { return !pred(v) || emit(v) })
+	}}
+}
+
+// ==================== 组合 ====================
+
+// serial 串行化多个并发生产者对同一个下游 emit 的调用
+type serial[T any] struct {
+	mu      sync.Mutex
+	emit    func(T) bool
+	cancel  context.CancelFunc
+	stopped bool
+}
+
+// with 在锁内执行 fn，下游拒绝后取消所有上游
+func (s *serial[T]) with(fn func(emit func(T) bool) bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return false
+	}
+	if !fn(s.emit) {
+		s.stopped = true
+		s.cancel()
+		return false
+	}
+	return true
+}
+
+// waitAll 并发等待所有上游，第一个错误会取消其余上游
+// 返回后不再调用下游 emit；下游主动停止时返回 nil
+func (s *serial[T]) waitAll(waits ...func() error) error {
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for _, wait := range waits {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := wait(); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					s.cancel()
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return nil
+	}
+	s.stopped = true
+	return firstErr
+}
+
+// Merge 合并多个 Observable 的值，全部完成后完成，任一出错时以该错误终止
+func Merge[T any](obs ...Observable[T]) Observable[T] {
+	return Observable[T]{subscribe: func(ctx context.Context, emit func(T) bool) func() error {
+		ctx, cancel := context.WithCancel(ctx)
+		s := &serial[T]{emit: emit, cancel: cancel}
+		waits := make([]func() error, len(obs))
+		for i, o := range obs {
+			waits[i] = o.subscribe(ctx, func(v T) bool {
+				return s.with(func(emit func(T) bool) bool { return emit(v) })
+			})
+		}
+		return func() error {
+			defer cancel()
+			return s.waitAll(waits...)
+		}
+	}}
+}
+
+// CombineLatest 任一来源产生新值时，用两者的最新值计算 fn
+// 两个来源都至少产生过一个值后才开始输出
+func CombineLatest[A, B, R any](a Observable[A], b Observable[B], fn func(A, B) R) Observable[R] {
+	return Observable[R]{subscribe: func(ctx context.Context, emit func(R) bool) func() error {
+		ctx, cancel := context.WithCancel(ctx)
+		s := &serial[R]{emit: emit, cancel: cancel}
+
+		// 以下状态由 s.mu 保护
+		var (
+			la         A
+			lb         B
+			hasA, hasB bool
+		)
+		waitA := a.subscribe(ctx, func(v A) bool {
+			return s.with(func(emit func(R) bool) bool {
+				la, hasA = v, true
+				return !hasB || emit(fn(la, lb))
+			})
+		})
+		waitB := b.subscribe(ctx, func(v B) bool {
+			return s.with(func(emit func(R) bool) bool {
+				lb, hasB = v, true
+				return !hasA || emit(fn(la, lb))
+			})
+		})
+		return func() error {
+			defer cancel()
+			return s.waitAll(waitA, waitB)
+		}
+	}}
+}
+
+// ==================== 时间 ====================
+
+// Debounce 只在值之后静默 d 时长才输出该值，来源完成时立即输出尚未输出的最后一个值
+func (o Observable[T]) Debounce(d time.Duration) Observable[T] {
+	return Observable[T]{subscribe: func(ctx context.Context, emit func(T) bool) func() error {
+		ctx, cancel := context.WithCancel(ctx)
+
+		var (
+			mu      sync.Mutex
+			pending *T
+			timer   *time.Timer
+			gen     int
+			closed  bool
+			stopped bool
+		)
+		fire := func(g int) {
+			mu.Lock()
+			defer mu.Unlock()
+			if closed || stopped || g != gen || pending == nil {
+				return
+			}
+			v := *pending
+			pending = nil
+			if !emit(v) {
+				stopped = true
+				cancel()
+			}
+		}
+
+		wait := o.subscribe(ctx, func(v T) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			if stopped {
+				return false
+			}
+			pending = &v
+			gen++
+			g := gen
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.AfterFunc(d, func() { fire(g) })
+			return true
+		})
+
+		return func() error {
+			defer cancel()
+			err := wait()
+
+			mu.Lock()
+			defer mu.Unlock()
+			closed = true
+			if timer != nil {
+				timer.Stop()
+			}
+			if stopped {
+				return nil
+			}
+			if err == nil && pending != nil && ctx.Err() == nil {
+				emit(*pending)
+			}
+			return err
+		}
+	}}
+}
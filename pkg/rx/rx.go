@@ -0,0 +1,202 @@
+// Package rx 提供推送式的可观察流。
+//
+// Observable 是冷的：每次订阅都会重新运行生产者；Subject 与 BehaviorSubject 是热的，
+// 所有订阅者共享同一串值。每个订阅都有独立的缓冲区，缓冲区满时按 Backpressure 策略处理：
+//
+//	sub := rx.Map(src, format).Subscribe(ctx, render,
+//		rx.WithBuffer(1), rx.WithBackpressure(rx.DropOldest))
+//	defer sub.Unsubscribe()
+package rx
+
+import (
+	"context"
+	"sync"
+)
+
+// Observable 推送式数据源
+// subscribe 同步完成订阅登记（热数据源在此注册订阅者），返回的 wait 负责推送并阻塞到流终止。
+// emit 返回 false 表示下游已不再需要，wait 应尽快返回；
+// wait 的返回值为流的终止错误，nil 表示正常完成。
+type Observable[T any] struct {
+	subscribe func(ctx context.Context, emit func(T) bool) (wait func() error)
+}
+
+// Create 从生产函数创建冷的 Observable，每次订阅运行一次 fn
+// fn 需要遵守 ctx 与 emit 的返回值
+func Create[T any](fn func(ctx context.Context, emit func(T) bool) error) Observable[T] {
+	return Observable[T]{subscribe: func(ctx context.Context, emit func(T) bool) func() error {
+		return func() error { return fn(ctx, emit) }
+	}}
+}
+
+// Empty 立即完成的 Observable
+func Empty[T any]() Observable[T] {
+	return Create(func(context.Context, func(T) bool) error { return nil })
+}
+
+// Fail 立即以 err 终止的 Observable
+func Fail[T any](err error) Observable[T] {
+	return Create(func(context.Context, func(T) bool) error { return err })
+}
+
+// ==================== 订阅 ====================
+
+// Backpressure 订阅缓冲区满时的处理策略
+type Backpressure int
+
+const (
+	// Block 阻塞生产者直到缓冲区有空间
+	Block Backpressure = iota
+	// DropNewest 丢弃新到的值
+	DropNewest
+	// DropOldest 丢弃缓冲区中最旧的值
+	DropOldest
+)
+
+// subscribeConfig 订阅配置
+type subscribeConfig struct {
+	buffer     int
+	policy     Backpressure
+	onError    func(error)
+	onComplete func()
+}
+
+// SubscribeOption 订阅配置选项
+type SubscribeOption func(*subscribeConfig)
+
+// WithBuffer 设置订阅缓冲区大小，默认 16；0 表示无缓冲，只能与 Block 一起使用
+func WithBuffer(n int) SubscribeOption {
+	return func(c *subscribeConfig) { c.buffer = n }
+}
+
+// WithBackpressure 设置缓冲区满时的策略，默认 Block
+func WithBackpressure(p Backpressure) SubscribeOption {
+	return func(c *subscribeConfig) { c.policy = p }
+}
+
+// OnError 流以错误终止时调用
+func OnError(fn func(error)) SubscribeOption {
+	return func(c *subscribeConfig) { c.onError = fn }
+}
+
+// OnComplete 流正常完成时调用
+func OnComplete(fn func()) SubscribeOption {
+	return func(c *subscribeConfig) { c.onComplete = fn }
+}
+
+// Subscription 订阅句柄
+type Subscription struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// Unsubscribe 取消订阅，不再调用任何回调
+func (s *Subscription) Unsubscribe() {
+	s.cancel()
+}
+
+// Done 在订阅结束（完成、出错或取消）且回调全部返回后关闭
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err 返回流的终止错误，应在 Done 关闭后调用；取消订阅或 ctx 结束时为 nil
+func (s *Subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Subscribe 订阅 Observable，onNext 在单独的 goroutine 中按顺序调用
+// 返回时订阅已经登记，之后发送到 Subject 的值不会丢失
+// ctx 结束等同于 Unsubscribe
+func (o Observable[T]) Subscribe(ctx context.Context, onNext func(T), opts ...SubscribeOption) *Subscription {
+	cfg := subscribeConfig{buffer: 16, policy: Block}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.buffer <= 0 {
+		cfg.buffer = 0
+		cfg.policy = Block
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &Subscription{cancel: cancel, done: make(chan struct{})}
+	q := &queue[T]{ctx: ctx, ch: make(chan T, cfg.buffer), policy: cfg.policy}
+
+	go func() {
+		defer close(sub.done)
+		defer cancel()
+		for v := range q.ch {
+			if ctx.Err() == nil {
+				onNext(v)
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		sub.mu.Lock()
+		sub.err = q.err
+		sub.mu.Unlock()
+
+		if q.err != nil {
+			if cfg.onError != nil {
+				cfg.onError(q.err)
+			}
+		} else if cfg.onComplete != nil {
+			cfg.onComplete()
+		}
+	}()
+
+	wait := o.subscribe(ctx, q.push)
+	go func() {
+		q.err = wait()
+		close(q.ch)
+	}()
+	return sub
+}
+
+// queue 单个订阅的缓冲区，push 只在生产者一侧串行调用
+type queue[T any] struct {
+	ctx    context.Context
+	ch     chan T
+	policy Backpressure
+	err    error
+}
+
+func (q *queue[T]) push(v T) bool {
+	if q.ctx.Err() != nil {
+		return false
+	}
+	switch q.policy {
+	case DropNewest:
+		select {
+		case q.ch <- v:
+		default:
+		}
+		return true
+	case DropOldest:
+		for {
+			select {
+			case q.ch <- v:
+				return true
+			default:
+			}
+			select {
+			case <-q.ch:
+			default:
+			}
+		}
+	default:
+		select {
+		case q.ch <- v:
+			return true
+		case <-q.ctx.Done():
+			return false
+		}
+	}
+}
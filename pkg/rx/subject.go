@@ -0,0 +1,164 @@
+package rx
+
+import (
+	"context"
+	"sync"
+)
+
+// Subject 热的 Observable，同时也是生产者
+// Next 会同步推送给当前所有订阅者，订阅者使用 Block 策略时会阻塞 Next。
+// 推送不持有 Subject 的锁，慢订阅者不会阻塞 Complete、Error 与新的订阅；
+// 但 Block 策略下缓冲区已满时，在 onNext 中对同一 Subject 调用 Next 会死锁。
+type Subject[T any] struct {
+	mu     sync.Mutex
+	subs   map[*subscriber[T]]struct{}
+	done   chan struct{}
+	closed bool
+	err    error
+
+	// replay 非 nil 时，新订阅者先收到 *replay（BehaviorSubject）
+	replay *T
+}
+
+// subscriber 订阅者的下游，emit 返回 false 时关闭 stop
+// mu 保证 emit 串行调用，且 removed 之后不再调用
+type subscriber[T any] struct {
+	emit func(T) bool
+	stop chan struct{}
+
+	mu      sync.Mutex
+	removed bool
+}
+
+// send 推送 v，订阅已移除时忽略
+func (sub *subscriber[T]) send(v T) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	return sub.removed || sub.emit(v)
+}
+
+// remove 标记订阅已移除，等待进行中的 send 返回
+func (sub *subscriber[T]) remove() {
+	sub.mu.Lock()
+	sub.removed = true
+	sub.mu.Unlock()
+}
+
+// NewSubject 创建 Subject
+func NewSubject[T any]() *Subject[T] {
+	return &Subject[T]{
+		subs: make(map[*subscriber[T]]struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Next 推送一个值，Subject 终止后忽略
+func (s *Subject[T]) Next(v T) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	if s.replay != nil {
+		*s.replay = v
+	}
+	subs := make([]*subscriber[T], 0, len(s.subs))
+	for sub := range s.subs {
+		subs = append(subs, sub)
+	}
+	s.mu.Unlock()
+
+	for _, sub := range subs {
+		if sub.send(v) {
+			continue
+		}
+		s.mu.Lock()
+		if _, ok := s.subs[sub]; ok {
+			delete(s.subs, sub)
+			close(sub.stop)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// Error 以 err 终止所有订阅
+func (s *Subject[T]) Error(err error) {
+	s.terminate(err)
+}
+
+// Complete 正常完成所有订阅
+func (s *Subject[T]) Complete() {
+	s.terminate(nil)
+}
+
+func (s *Subject[T]) terminate(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.err = err
+	clear(s.subs)
+	close(s.done)
+}
+
+// Observable 返回订阅该 Subject 的 Observable，Subject 终止后订阅会立即结束
+func (s *Subject[T]) Observable() Observable[T] {
+	return Observable[T]{subscribe: func(ctx context.Context, emit func(T) bool) func() error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.closed {
+			err := s.err
+			return func() error { return err }
+		}
+		if s.replay != nil && !emit(*s.replay) {
+			return func() error { return nil }
+		}
+		sub := &subscriber[T]{emit: emit, stop: make(chan struct{})}
+		s.subs[sub] = struct{}{}
+		return func() error { return s.wait(ctx, sub) }
+	}}
+}
+
+// wait 阻塞到订阅结束，返回后 Next 不会再调用 emit
+func (s *Subject[T]) wait(ctx context.Context, sub *subscriber[T]) error {
+	select {
+	case <-ctx.Done():
+	case <-s.done:
+	case <-sub.stop:
+	}
+
+	s.mu.Lock()
+	delete(s.subs, sub)
+	err := s.err
+	s.mu.Unlock()
+	sub.remove()
+	return err
+}
+
+// Subscribe 等价于 s.Observable().Subscribe
+func (s *Subject[T]) Subscribe(ctx context.Context, onNext func(T), opts ...SubscribeOption) *Subscription {
+	return s.Observable().Subscribe(ctx, onNext, opts...)
+}
+
+// ==================== BehaviorSubject ====================
+
+// BehaviorSubject 保存最新值的 Subject，新订阅者会先收到当前值
+type BehaviorSubject[T any] struct {
+	*Subject[T]
+}
+
+// NewBehaviorSubject 以 initial 为当前值创建 BehaviorSubject
+func NewBehaviorSubject[T any](initial T) *BehaviorSubject[T] {
+	s := NewSubject[T]()
+	s.replay = &initial
+	return &BehaviorSubject[T]{Subject: s}
+}
+
+// Value 返回当前值
+func (b *BehaviorSubject[T]) Value() T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return *b.replay
+}
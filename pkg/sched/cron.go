@@ -0,0 +1,249 @@
+package sched
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule 描述任务的触发时间表
+type Schedule interface {
+	// Next 返回严格晚于 t 的下一次触发时间，零值表示不再触发
+	Next(t time.Time) time.Time
+}
+
+// ==================== 固定间隔 ====================
+
+// intervalSchedule 固定间隔触发
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+// Every 创建固定间隔的 Schedule
+func Every(interval time.Duration) Schedule {
+	if interval <= 0 {
+		panic("sched: non-positive interval for Every")
+	}
+	return intervalSchedule{interval: interval}
+}
+
+func (s intervalSchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// ==================== Cron 表达式 ====================
+
+// cronSchedule 标准五字段 cron 表达式（分 时 日 月 周）
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+	loc                           *time.Location
+}
+
+// cronField 描述单个字段的取值范围
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = cronField{min: 0, max: 59}
+	hourField   = cronField{min: 0, max: 23}
+	domField    = cronField{min: 1, max: 31}
+	monthField  = cronField{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// descriptors 预定义的 cron 描述符
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron 解析 cron 表达式，使用本地时区
+// 支持五字段格式、范围(a-b)、步长(*/n)、列表(a,b)、月份/星期名称，
+// 以及 @hourly/@daily 等描述符和 "@every <duration>"
+func ParseCron(expr string) (Schedule, error) {
+	return ParseCronIn(expr, time.Local)
+}
+
+// ParseCronIn 在指定时区解析 cron 表达式
+func ParseCronIn(expr string, loc *time.Location) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if rest, ok := strings.CutPrefix(expr, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("sched: invalid @every duration %q: %w", rest, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("sched: non-positive @every duration %q", rest)
+		}
+		return Every(d), nil
+	}
+	if d, ok := descriptors[strings.ToLower(expr)]; ok {
+		expr = d
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("sched: expected 5 fields in cron expression %q, got %d", expr, len(fields))
+	}
+
+	s := &cronSchedule{loc: loc}
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if s.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if s.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	// 周日既可以写作 0 也可以写作 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = fields[2] == "*" || fields[2] == "?"
+	s.dowStar = fields[4] == "*" || fields[4] == "?"
+	return s, nil
+}
+
+// MustParseCron 解析 cron 表达式，失败时 panic
+func MustParseCron(expr string) Schedule {
+	s, err := ParseCron(expr)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// parse 将字段解析为位图
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		b, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func (f cronField) parsePart(part string) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepPart)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("sched: invalid step in %q", part)
+		}
+		step = n
+	}
+
+	lo, hi := f.min, f.max
+	switch {
+	case rangePart == "*" || rangePart == "?":
+	case strings.Contains(rangePart, "-"):
+		a, b, _ := strings.Cut(rangePart, "-")
+		var err error
+		if lo, err = f.value(a); err != nil {
+			return 0, err
+		}
+		if hi, err = f.value(b); err != nil {
+			return 0, err
+		}
+	default:
+		v, err := f.value(rangePart)
+		if err != nil {
+			return 0, err
+		}
+		lo = v
+		// "5/10" 表示从 5 开始每 10 个单位
+		if hasStep {
+			hi = f.max
+		} else {
+			hi = v
+		}
+	}
+	if lo > hi {
+		return 0, fmt.Errorf("sched: invalid range in %q", part)
+	}
+
+	var bits uint64
+	for i := lo; i <= hi; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("sched: invalid value %q", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("sched: value %d out of range [%d, %d]", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next 计算下一次触发时间，五年内无匹配时返回零值
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	orig := t.Location()
+	t = t.In(s.loc)
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, s.loc)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.loc)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, s.loc)
+			continue
+		}
+		return t.In(orig)
+	}
+	return time.Time{}
+}
+
+// dayMatches 日与周同时受限时任一匹配即可，与传统 cron 一致
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domOK := s.dom&(1<<uint(t.Day())) != 0
+	dowOK := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domOK && dowOK
+	}
+	return domOK || dowOK
+}
@@ -0,0 +1,302 @@
+package sched
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+	"github.com/hunter-hongg/GoPlus/pkg/metrics"
+)
+
+// ErrClosed 调度器已关闭
+var ErrClosed = errors.New("sched: scheduler closed")
+
+// OverlapPolicy 上一次运行尚未结束时的处理策略
+type OverlapPolicy int
+
+const (
+	// OverlapSkip 跳过本次触发
+	OverlapSkip OverlapPolicy = iota
+	// OverlapQueue 排队，待上一次结束后依次运行
+	OverlapQueue
+	// OverlapConcurrent 允许并发运行
+	OverlapConcurrent
+)
+
+// ==================== 任务选项 ====================
+
+// jobConfig 任务配置
+type jobConfig struct {
+	overlap OverlapPolicy
+	jitter  time.Duration
+	name    string
+}
+
+// JobOption 任务配置选项
+type JobOption func(*jobConfig)
+
+// WithOverlap 设置重叠策略，默认 OverlapSkip
+func WithOverlap(p OverlapPolicy) JobOption {
+	return func(c *jobConfig) { c.overlap = p }
+}
+
+// WithJitter 为每次触发增加 [0, d) 的随机延迟
+func WithJitter(d time.Duration) JobOption {
+	return func(c *jobConfig) { c.jitter = d }
+}
+
+// WithName 设置上报指标时使用的 job 标签
+// 指标：sched_runs_total、sched_failures_total、sched_skipped_total、sched_run_seconds
+func WithName(name string) JobOption {
+	return func(c *jobConfig) { c.name = name }
+}
+
+// ==================== 调度器选项 ====================
+
+// TimerFunc 创建定时器，返回到期通道和停止函数
+type TimerFunc func(d time.Duration) (<-chan time.Time, func() bool)
+
+// Option 调度器配置选项
+type Option func(*Scheduler)
+
+// WithTimers 替换默认的 time.NewTimer，例如使用 timex 时间轮管理大量任务
+func WithTimers(fn TimerFunc) Option {
+	return func(s *Scheduler) { s.timers = fn }
+}
+
+// ==================== 调度器 ====================
+
+// Scheduler 按 Schedule 触发任务，每次运行的结果以 future.Future 表示
+type Scheduler struct {
+	mu     sync.Mutex
+	jobs   map[*Job]struct{}
+	closed bool
+	wg     sync.WaitGroup
+	timers TimerFunc
+
+	// runCtx 传递给任务函数，在关闭超时后取消
+	runCtx    context.Context
+	runCancel context.CancelFunc
+}
+
+// New 创建调度器
+func New(opts ...Option) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Scheduler{
+		jobs:      make(map[*Job]struct{}),
+		runCtx:    ctx,
+		runCancel: cancel,
+		timers: func(d time.Duration) (<-chan time.Time, func() bool) {
+			t := time.NewTimer(d)
+			return t.C, t.Stop
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Schedule 按给定时间表注册任务
+func (s *Scheduler) Schedule(sch Schedule, fn func(ctx context.Context) error, opts ...JobOption) (*Job, error) {
+	cfg := jobConfig{overlap: OverlapSkip}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	j := &Job{
+		s:    s,
+		sch:  sch,
+		fn:   fn,
+		cfg:  cfg,
+		stop: make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, ErrClosed
+	}
+	s.jobs[j] = struct{}{}
+	s.wg.Add(1)
+	go j.loop()
+	return j, nil
+}
+
+// Cron 按 cron 表达式注册任务
+func (s *Scheduler) Cron(expr string, fn func(ctx context.Context) error, opts ...JobOption) (*Job, error) {
+	sch, err := ParseCron(expr)
+	if err != nil {
+		return nil, err
+	}
+	return s.Schedule(sch, fn, opts...)
+}
+
+// Every 按固定间隔注册任务
+func (s *Scheduler) Every(interval time.Duration, fn func(ctx context.Context) error, opts ...JobOption) (*Job, error) {
+	return s.Schedule(Every(interval), fn, opts...)
+}
+
+// Shutdown 停止触发新的运行并等待正在运行的任务结束
+// ctx 结束时取消任务的 Context 并返回 ctx.Err()
+func (s *Scheduler) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		for j := range s.jobs {
+			j.Stop()
+		}
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.runCancel()
+		return nil
+	case <-ctx.Done():
+		s.runCancel()
+		return ctx.Err()
+	}
+}
+
+// ==================== 任务句柄 ====================
+
+// Job 已注册任务的句柄
+type Job struct {
+	s    *Scheduler
+	sch  Schedule
+	fn   func(ctx context.Context) error
+	cfg  jobConfig
+	stop chan struct{}
+
+	mu       sync.Mutex
+	next     time.Time
+	last     future.Future[struct{}]
+	running  int
+	queued   int
+	stopped  bool
+	stopOnce sync.Once
+}
+
+// Next 返回下一次计划触发时间，任务停止后返回零值
+func (j *Job) Next() time.Time {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.next
+}
+
+// Last 返回最近一次运行的 Future，尚未运行过时返回 nil
+func (j *Job) Last() future.Future[struct{}] {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.last
+}
+
+// Stop 停止后续触发，已在运行的任务不受影响
+func (j *Job) Stop() {
+	j.stopOnce.Do(func() {
+		j.mu.Lock()
+		j.stopped = true
+		j.queued = 0
+		j.next = time.Time{}
+		j.mu.Unlock()
+		close(j.stop)
+	})
+}
+
+func (j *Job) loop() {
+	defer j.s.wg.Done()
+	defer func() {
+		j.s.mu.Lock()
+		delete(j.s.jobs, j)
+		j.s.mu.Unlock()
+	}()
+
+	for {
+		now := time.Now()
+		next := j.sch.Next(now)
+		if next.IsZero() {
+			j.Stop()
+			return
+		}
+		if j.cfg.jitter > 0 {
+			next = next.Add(rand.N(j.cfg.jitter))
+		}
+
+		j.mu.Lock()
+		j.next = next
+		j.mu.Unlock()
+
+		c, stop := j.s.timers(next.Sub(now))
+		select {
+		case <-j.stop:
+			stop()
+			return
+		case <-c:
+			j.trigger()
+		}
+	}
+}
+
+// trigger 根据重叠策略决定是否启动一次运行
+func (j *Job) trigger() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.stopped {
+		return
+	}
+	if j.running > 0 {
+		switch j.cfg.overlap {
+		case OverlapSkip:
+			metrics.Default().Counter("sched_skipped_total", j.label()).Inc()
+			return
+		case OverlapQueue:
+			j.queued++
+			return
+		}
+	}
+	j.startLocked()
+}
+
+// startLocked 启动一次运行，调用方需持有 j.mu
+func (j *Job) startLocked() {
+	j.running++
+	j.s.wg.Add(1)
+	ctx := j.s.runCtx
+	j.last = future.NewE(func() (struct{}, error) {
+		defer j.finish()
+		p := metrics.Default()
+		p.Counter("sched_runs_total", j.label()).Inc()
+		start := time.Now()
+		err := j.fn(ctx)
+		p.Histogram("sched_run_seconds", j.label()).Observe(time.Since(start).Seconds())
+		if err != nil {
+			p.Counter("sched_failures_total", j.label()).Inc()
+		}
+		return struct{}{}, err
+	})
+}
+
+func (j *Job) label() metrics.Label {
+	return metrics.L("job", j.cfg.name)
+}
+
+func (j *Job) finish() {
+	defer j.s.wg.Done()
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.running--
+	if j.queued > 0 && !j.stopped {
+		j.queued--
+		j.startLocked()
+	}
+}
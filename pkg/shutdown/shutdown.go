@@ -0,0 +1,324 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+)
+
+var (
+	// ErrTimeout 钩子在超时时间内没有完成
+	ErrTimeout = errors.New("shutdown: hook timed out")
+	// ErrUnknownDependency 钩子依赖了未注册的钩子
+	ErrUnknownDependency = errors.New("shutdown: unknown dependency")
+	// ErrCycle 钩子依赖存在环
+	ErrCycle = errors.New("shutdown: dependency cycle")
+)
+
+// ==================== 配置 ====================
+
+// config 管理器配置
+type config struct {
+	signals []os.Signal
+	timeout time.Duration
+}
+
+// Option 管理器配置选项
+type Option func(*config)
+
+// WithSignals 设置触发关闭的信号，默认 SIGINT 和 SIGTERM
+func WithSignals(sigs ...os.Signal) Option {
+	return func(c *config) { c.signals = sigs }
+}
+
+// WithDefaultTimeout 设置钩子的默认超时，默认 10 秒
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(c *config) { c.timeout = d }
+}
+
+// HookOption 钩子配置选项
+type HookOption func(*Hook)
+
+// After 声明钩子在指定钩子完成（包括失败或超时）之后才执行
+func After(names ...string) HookOption {
+	return func(h *Hook) { h.after = append(h.after, names...) }
+}
+
+// WithTimeout 设置钩子的超时时间
+func WithTimeout(d time.Duration) HookOption {
+	return func(h *Hook) { h.timeout = d }
+}
+
+// ==================== 钩子 ====================
+
+// Hook 已注册的关闭钩子
+type Hook struct {
+	name    string
+	fn      func(ctx context.Context) error
+	after   []string
+	timeout time.Duration
+
+	start    chan struct{}
+	deps     []*Hook
+	startErr error
+	fut      future.Future[struct{}]
+}
+
+// Name 返回钩子名称
+func (h *Hook) Name() string { return h.name }
+
+// Future 返回钩子完成的 Future，关闭开始前处于等待状态
+// Error() 为钩子返回的错误，超时时为 ErrTimeout
+func (h *Hook) Future() future.Future[struct{}] { return h.fut }
+
+// run 等待依赖完成后在超时限制内执行钩子
+func (h *Hook) run() (struct{}, error) {
+	<-h.start
+	if h.startErr != nil {
+		return struct{}{}, h.startErr
+	}
+	for _, d := range h.deps {
+		d.fut.Wait()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- h.fn(ctx)
+	}()
+	select {
+	case err := <-done:
+		return struct{}{}, err
+	case <-ctx.Done():
+		return struct{}{}, fmt.Errorf("%w: %q after %v", ErrTimeout, h.name, h.timeout)
+	}
+}
+
+// ==================== 管理器 ====================
+
+// Report 关闭结果
+type Report struct {
+	// Completed 成功完成的钩子
+	Completed []string
+	// Failed 返回错误的钩子（不含超时）
+	Failed map[string]error
+	// TimedOut 超时的钩子
+	TimedOut []string
+	// Duration 整个关闭过程的耗时
+	Duration time.Duration
+}
+
+// Err 合并所有失败和超时的错误，全部成功时返回 nil
+func (r *Report) Err() error {
+	names := make([]string, 0, len(r.Failed))
+	for name := range r.Failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	errs := make([]error, 0, len(names)+len(r.TimedOut))
+	for _, name := range names {
+		errs = append(errs, fmt.Errorf("shutdown: hook %q: %w", name, r.Failed[name]))
+	}
+	for _, name := range r.TimedOut {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrTimeout, name))
+	}
+	return errors.Join(errs...)
+}
+
+// Manager 管理关闭钩子，按依赖顺序执行，相互独立的钩子并发执行
+type Manager struct {
+	cfg config
+
+	mu      sync.Mutex
+	hooks   []*Hook
+	byName  map[string]*Hook
+	once    sync.Once
+	started time.Time
+	done    chan struct{}
+	report  *Report
+}
+
+// New 创建关闭管理器
+func New(opts ...Option) *Manager {
+	cfg := config{
+		signals: []os.Signal{os.Interrupt, syscall.SIGTERM},
+		timeout: 10 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Manager{
+		cfg:    cfg,
+		byName: make(map[string]*Hook),
+		done:   make(chan struct{}),
+	}
+}
+
+// Register 注册关闭钩子，名称重复或关闭已经开始时 panic
+func (m *Manager) Register(name string, fn func(ctx context.Context) error, opts ...HookOption) *Hook {
+	h := &Hook{
+		name:    name,
+		fn:      fn,
+		timeout: m.cfg.timeout,
+		start:   make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	h.fut = future.NewE(h.run)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.started.IsZero() {
+		panic("shutdown: Register called after shutdown started")
+	}
+	if _, ok := m.byName[name]; ok {
+		panic(fmt.Sprintf("shutdown: duplicate hook %q", name))
+	}
+	m.byName[name] = h
+	m.hooks = append(m.hooks, h)
+	return h
+}
+
+// Done 返回在关闭完成后关闭的通道
+func (m *Manager) Done() <-chan struct{} { return m.done }
+
+// Wait 等待信号或 ctx 结束后执行关闭，返回关闭结果
+func (m *Manager) Wait(ctx context.Context) *Report {
+	sigCh := make(chan os.Signal, 1)
+	if len(m.cfg.signals) > 0 {
+		signal.Notify(sigCh, m.cfg.signals...)
+		defer signal.Stop(sigCh)
+	}
+
+	select {
+	case <-sigCh:
+	case <-ctx.Done():
+	case <-m.done:
+		return m.result()
+	}
+	return m.Shutdown(context.Background())
+}
+
+// Shutdown 开始关闭并等待所有钩子完成或 ctx 结束，可以重复调用
+// ctx 先结束时返回的报告中，尚未完成的钩子计为超时
+func (m *Manager) Shutdown(ctx context.Context) *Report {
+	m.once.Do(m.begin)
+
+	select {
+	case <-m.done:
+		return m.result()
+	case <-ctx.Done():
+		return m.collect()
+	}
+}
+
+// begin 解析依赖并放行所有钩子
+func (m *Manager) begin() {
+	m.mu.Lock()
+	m.started = time.Now()
+	hooks := m.hooks
+	m.mu.Unlock()
+
+	err := m.resolve(hooks)
+	for _, h := range hooks {
+		h.startErr = err
+		close(h.start)
+	}
+
+	go func() {
+		for _, h := range hooks {
+			h.fut.Wait()
+		}
+		r := m.collect()
+		m.mu.Lock()
+		m.report = r
+		m.mu.Unlock()
+		close(m.done)
+	}()
+}
+
+// resolve 解析 After 声明并检查环
+func (m *Manager) resolve(hooks []*Hook) error {
+	for _, h := range hooks {
+		h.deps = h.deps[:0]
+		for _, name := range h.after {
+			d, ok := m.byName[name]
+			if !ok {
+				return fmt.Errorf("%w: %q required by %q", ErrUnknownDependency, name, h.name)
+			}
+			h.deps = append(h.deps, d)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[*Hook]int, len(hooks))
+	var visit func(h *Hook) error
+	visit = func(h *Hook) error {
+		switch state[h] {
+		case visiting:
+			return fmt.Errorf("%w at %q", ErrCycle, h.name)
+		case visited:
+			return nil
+		}
+		state[h] = visiting
+		for _, d := range h.deps {
+			if err := visit(d); err != nil {
+				return err
+			}
+		}
+		state[h] = visited
+		return nil
+	}
+	for _, h := range hooks {
+		if err := visit(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) result() *Report {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.report
+}
+
+// collect 汇总当前各钩子的状态，未完成的计为超时
+func (m *Manager) collect() *Report {
+	m.mu.Lock()
+	hooks := m.hooks
+	started := m.started
+	m.mu.Unlock()
+
+	r := &Report{Failed: make(map[string]error), Duration: time.Since(started)}
+	for _, h := range hooks {
+		if !h.fut.IsDone() {
+			r.TimedOut = append(r.TimedOut, h.name)
+			continue
+		}
+		switch err := h.fut.Error(); {
+		case err == nil:
+			r.Completed = append(r.Completed, h.name)
+		case errors.Is(err, ErrTimeout):
+			r.TimedOut = append(r.TimedOut, h.name)
+		default:
+			r.Failed[h.name] = err
+		}
+	}
+	return r
+}
@@ -0,0 +1,350 @@
+package stream
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// Stream 惰性的流式数据管道，基于 iter.Seq
+// 除终结操作外，所有操作都返回新的 Stream，不会修改原 Stream
+type Stream[T any] struct {
+	seq     iter.Seq[T]
+	workers int
+}
+
+// ==================== 构造函数 ====================
+
+// Of 从切片创建 Stream
+func Of[T any](xs []T) Stream[T] {
+	return Stream[T]{seq: slices.Values(xs)}
+}
+
+// Just 从参数列表创建 Stream
+func Just[T any](xs ...T) Stream[T] {
+	return Of(xs)
+}
+
+// FromSeq 从迭代器创建 Stream
+func FromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// FromChan 从通道创建 Stream，读取直到通道关闭
+func FromChan[T any](ch <-chan T) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Generate 用 fn 生成无限流，通常与 Limit 一起使用
+func Generate[T any](fn func() T) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for yield(fn()) {
+		}
+	}}
+}
+
+// Iterate 生成 seed, f(seed), f(f(seed)), ... 的无限流
+func Iterate[T any](seed T, f func(T) T) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := seed; yield(v); v = f(v) {
+		}
+	}}
+}
+
+// ==================== 中间操作 ====================
+
+// Parallel 让后续的 Filter/Map/Peek 使用 n 个 goroutine 并发执行，结果保持原有顺序
+// 并发阶段会先收集上游的全部元素，因此不适用于无限流；n <= 1 恢复串行
+func (s Stream[T]) Parallel(n int) Stream[T] {
+	s.workers = n
+	return s
+}
+
+// Sequential 恢复串行执行
+func (s Stream[T]) Sequential() Stream[T] {
+	return s.Parallel(0)
+}
+
+// Filter 保留满足 pred 的元素
+func (s Stream[T]) Filter(pred func(T) bool) Stream[T] {
+	if s.workers > 1 {
+		return parallelMap(s, func(v T) (T, bool) { return v, pred(v) })
+	}
+	seq := s.seq
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}, workers: s.workers}
+}
+
+// Map 转换每个元素，类型不变；转换为其他类型请使用 stream.Map
+func (s Stream[T]) Map(f func(T) T) Stream[T] {
+	return Map(s, f)
+}
+
+// Peek 对每个流过的元素执行 f
+func (s Stream[T]) Peek(f func(T)) Stream[T] {
+	return s.Map(func(v T) T {
+		f(v)
+		return v
+	})
+}
+
+// Distinct 去除重复元素，元素的动态类型必须可比较，否则 panic
+func (s Stream[T]) Distinct() Stream[T] {
+	seq := s.seq
+	return Stream[T]{seq: func(yield func(T) bool) {
+		seen := make(map[any]struct{})
+		for v := range seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}, workers: s.workers}
+}
+
+// Limit 最多保留前 n 个元素
+func (s Stream[T]) Limit(n int) Stream[T] {
+	seq := s.seq
+	return Stream[T]{seq: func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}, workers: s.workers}
+}
+
+// Skip 跳过前 n 个元素
+func (s Stream[T]) Skip(n int) Stream[T] {
+	seq := s.seq
+	return Stream[T]{seq: func(yield func(T) bool) {
+		i := 0
+		for v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}, workers: s.workers}
+}
+
+// TakeWhile 保留元素直到 pred 第一次不满足
+func (s Stream[T]) TakeWhile(pred func(T) bool) Stream[T] {
+	seq := s.seq
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range seq {
+			if !pred(v) || !yield(v) {
+				return
+			}
+		}
+	}, workers: s.workers}
+}
+
+// Sorted 按比较函数排序（需要收集全部元素）
+func (s Stream[T]) Sorted(cmp func(a, b T) int) Stream[T] {
+	seq := s.seq
+	return Stream[T]{seq: func(yield func(T) bool) {
+		xs := slices.Collect(seq)
+		slices.SortStableFunc(xs, cmp)
+		for _, v := range xs {
+			if !yield(v) {
+				return
+			}
+		}
+	}, workers: s.workers}
+}
+
+// ==================== 终结操作 ====================
+
+// Seq 返回底层迭代器
+func (s Stream[T]) Seq() iter.Seq[T] { return s.seq }
+
+// Collect 收集为切片
+func (s Stream[T]) Collect() []T {
+	return slices.Collect(s.seq)
+}
+
+// ForEach 对每个元素执行 f
+func (s Stream[T]) ForEach(f func(T)) {
+	for v := range s.seq {
+		f(v)
+	}
+}
+
+// Count 返回元素个数
+func (s Stream[T]) Count() int {
+	n := 0
+	for range s.seq {
+		n++
+	}
+	return n
+}
+
+// First 返回第一个元素
+func (s Stream[T]) First() option.Option[T] {
+	for v := range s.seq {
+		return option.Some(v)
+	}
+	return option.None[T]()
+}
+
+// Find 返回第一个满足 pred 的元素
+func (s Stream[T]) Find(pred func(T) bool) option.Option[T] {
+	return s.Sequential().Filter(pred).First()
+}
+
+// AnyMatch 是否存在满足 pred 的元素
+func (s Stream[T]) AnyMatch(pred func(T) bool) bool {
+	return s.Find(pred).IsSome()
+}
+
+// AllMatch 是否所有元素都满足 pred
+func (s Stream[T]) AllMatch(pred func(T) bool) bool {
+	return !s.AnyMatch(func(v T) bool { return !pred(v) })
+}
+
+// Reduce 用 f 归约所有元素，流为空时返回 None
+func (s Stream[T]) Reduce(f func(acc, v T) T) option.Option[T] {
+	var acc T
+	first := true
+	for v := range s.seq {
+		if first {
+			acc, first = v, false
+			continue
+		}
+		acc = f(acc, v)
+	}
+	if first {
+		return option.None[T]()
+	}
+	return option.Some(acc)
+}
+
+// Chan 将元素发送到返回的通道，ctx 取消或流结束时关闭通道
+func (s Stream[T]) Chan(ctx context.Context) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for v := range s.seq {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// ==================== 类型转换操作 ====================
+
+// Map 将 Stream[T] 转换为 Stream[U]
+func Map[T, U any](s Stream[T], f func(T) U) Stream[U] {
+	if s.workers > 1 {
+		return parallelMap(s, func(v T) (U, bool) { return f(v), true })
+	}
+	seq := s.seq
+	return Stream[U]{seq: func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}, workers: s.workers}
+}
+
+// FlatMap 将每个元素展开为一个 Stream 并拼接
+func FlatMap[T, U any](s Stream[T], f func(T) Stream[U]) Stream[U] {
+	seq := s.seq
+	return Stream[U]{seq: func(yield func(U) bool) {
+		for v := range seq {
+			for u := range f(v).seq {
+				if !yield(u) {
+					return
+				}
+			}
+		}
+	}, workers: s.workers}
+}
+
+// Fold 以 init 为初始值归约为其他类型
+func Fold[T, A any](s Stream[T], init A, f func(A, T) A) A {
+	acc := init
+	for v := range s.seq {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// GroupBy 按 key 分组
+func GroupBy[T any, K comparable](s Stream[T], key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for v := range s.seq {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// ==================== 并发执行 ====================
+
+// parallelMap 使用 s.workers 个 goroutine 并发处理，保持输入顺序
+// f 返回 false 的元素被丢弃
+func parallelMap[T, U any](s Stream[T], f func(T) (U, bool)) Stream[U] {
+	seq, workers := s.seq, s.workers
+	return Stream[U]{seq: func(yield func(U) bool) {
+		in := slices.Collect(seq)
+		out := make([]U, len(in))
+		keep := make([]bool, len(in))
+
+		var wg sync.WaitGroup
+		next := make(chan int)
+		for range min(workers, len(in)) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range next {
+					out[i], keep[i] = f(in[i])
+				}
+			}()
+		}
+		for i := range in {
+			next <- i
+		}
+		close(next)
+		wg.Wait()
+
+		for i, v := range out {
+			if keep[i] && !yield(v) {
+				return
+			}
+		}
+	}, workers: workers}
+}
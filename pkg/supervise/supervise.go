@@ -0,0 +1,435 @@
+package supervise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/errorsx"
+)
+
+// ErrTooManyRestarts 重启频率超过上限，监督者放弃
+var ErrTooManyRestarts = errors.New("supervise: too many restarts")
+
+// Strategy 子任务退出时的重启策略
+type Strategy int
+
+const (
+	// OneForOne 只重启退出的子任务
+	OneForOne Strategy = iota
+	// OneForAll 停止并重启所有子任务
+	OneForAll
+)
+
+// RestartPolicy 子任务自身的重启条件
+type RestartPolicy int
+
+const (
+	// Permanent 无论如何退出都重启
+	Permanent RestartPolicy = iota
+	// Transient 仅在返回错误或 panic 时重启
+	Transient
+	// Temporary 从不重启
+	Temporary
+)
+
+// Child 被监督的子任务
+// Run 应当在 ctx 取消后尽快返回
+type Child struct {
+	Name    string
+	Run     func(ctx context.Context) error
+	Restart RestartPolicy
+}
+
+// ==================== 配置 ====================
+
+// config 监督者配置
+type config struct {
+	strategy    Strategy
+	maxRestarts int
+	period      time.Duration
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	onEvent     func(Event)
+}
+
+// Option 监督者配置选项
+type Option func(*config)
+
+// WithStrategy 设置重启策略，默认 OneForOne
+func WithStrategy(s Strategy) Option {
+	return func(c *config) { c.strategy = s }
+}
+
+// WithIntensity 设置在 period 内最多允许 maxRestarts 次重启，默认 5 次/5 秒
+func WithIntensity(maxRestarts int, period time.Duration) Option {
+	return func(c *config) {
+		c.maxRestarts = maxRestarts
+		c.period = period
+	}
+}
+
+// WithBackoff 设置重启的指数退避范围，默认 10ms 到 10s
+func WithBackoff(min, max time.Duration) Option {
+	return func(c *config) {
+		c.minBackoff = min
+		c.maxBackoff = max
+	}
+}
+
+// WithEventHandler 设置事件回调，用于日志或存活性上报
+// 回调在监督者的 goroutine 中同步调用，不应阻塞
+func WithEventHandler(fn func(Event)) Option {
+	return func(c *config) { c.onEvent = fn }
+}
+
+// ==================== 事件与状态 ====================
+
+// EventKind 事件类型
+type EventKind int
+
+const (
+	// EventStarted 子任务已启动
+	EventStarted EventKind = iota
+	// EventExited 子任务已退出
+	EventExited
+	// EventRestarting 子任务将在退避后重启
+	EventRestarting
+	// EventGaveUp 重启过于频繁，监督者停止
+	EventGaveUp
+)
+
+// Event 监督事件
+type Event struct {
+	Supervisor string
+	Child      string
+	Kind       EventKind
+	Err        error
+	Restarts   int
+	Backoff    time.Duration
+}
+
+// ChildStatus 子任务的存活状态
+type ChildStatus struct {
+	Name      string
+	Running   bool
+	Restarts  int
+	LastError error
+	StartedAt time.Time
+}
+
+// ==================== 监督者 ====================
+
+// childState 子任务运行时状态
+type childState struct {
+	spec     Child
+	cancel   context.CancelFunc
+	running  bool
+	finished bool
+	restarts int
+	failures int
+	lastErr  error
+	started  time.Time
+}
+
+// exitMsg 子任务退出通知
+type exitMsg struct {
+	idx int
+	err error
+}
+
+// Supervisor 监督一组长期运行的子任务，可以作为另一个监督者的子任务形成监督树
+type Supervisor struct {
+	name string
+	cfg  config
+
+	mu       sync.Mutex
+	children []*childState
+	running  bool
+}
+
+// New 创建监督者
+func New(name string, opts ...Option) *Supervisor {
+	cfg := config{
+		strategy:    OneForOne,
+		maxRestarts: 5,
+		period:      5 * time.Second,
+		minBackoff:  10 * time.Millisecond,
+		maxBackoff:  10 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Supervisor{name: name, cfg: cfg}
+}
+
+// Add 添加子任务，必须在 Run 之前调用
+func (s *Supervisor) Add(children ...Child) *Supervisor {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		panic("supervise: Add called on running supervisor")
+	}
+	for _, c := range children {
+		s.children = append(s.children, &childState{spec: c})
+	}
+	return s
+}
+
+// AsChild 将监督者包装为子任务，用于构建监督树
+func (s *Supervisor) AsChild(policy RestartPolicy) Child {
+	return Child{Name: s.name, Run: s.Run, Restart: policy}
+}
+
+// Status 返回所有子任务的存活状态快照
+func (s *Supervisor) Status() []ChildStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]ChildStatus, len(s.children))
+	for i, c := range s.children {
+		out[i] = ChildStatus{
+			Name:      c.spec.Name,
+			Running:   c.running,
+			Restarts:  c.restarts,
+			LastError: c.lastErr,
+			StartedAt: c.started,
+		}
+	}
+	return out
+}
+
+// Alive 所有应当运行的子任务是否都在运行
+func (s *Supervisor) Alive() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.running {
+		return false
+	}
+	for _, c := range s.children {
+		if !c.running && !c.finished {
+			return false
+		}
+	}
+	return true
+}
+
+// Run 启动所有子任务并监督，直到 ctx 取消或重启过于频繁
+// ctx 取消时返回 nil；放弃时返回包装了 ErrTooManyRestarts 的错误
+func (s *Supervisor) Run(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return fmt.Errorf("supervise: supervisor %q already running", s.name)
+	}
+	s.running = true
+	for _, c := range s.children {
+		c.running, c.finished, c.failures = false, false, 0
+	}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r := &runState{
+		s:       s,
+		ctx:     ctx,
+		exits:   make(chan exitMsg),
+		restart: make(chan int),
+	}
+	for i := range s.children {
+		r.start(i)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.stopAll()
+			return nil
+		case idx := <-r.restart:
+			if idx < 0 {
+				for i, c := range s.children {
+					if !c.finished {
+						r.start(i)
+					}
+				}
+			} else {
+				r.start(idx)
+			}
+		case m := <-r.exits:
+			if err := r.handleExit(m); err != nil {
+				r.stopAll()
+				return err
+			}
+		}
+	}
+}
+
+// runState 一次 Run 调用的状态，只在监督者 goroutine 中访问
+type runState struct {
+	s        *Supervisor
+	ctx      context.Context
+	exits    chan exitMsg
+	restart  chan int
+	history  []time.Time
+	inflight int
+}
+
+func (r *runState) emit(e Event) {
+	if r.s.cfg.onEvent != nil {
+		e.Supervisor = r.s.name
+		r.s.cfg.onEvent(e)
+	}
+}
+
+// start 启动第 idx 个子任务
+func (r *runState) start(idx int) {
+	s := r.s
+	s.mu.Lock()
+	c := s.children[idx]
+	if c.running {
+		s.mu.Unlock()
+		return
+	}
+	childCtx, cancel := context.WithCancel(r.ctx)
+	c.cancel = cancel
+	c.running = true
+	c.finished = false
+	c.started = time.Now()
+	spec := c.spec
+	restarts := c.restarts
+	s.mu.Unlock()
+
+	r.inflight++
+	r.emit(Event{Child: spec.Name, Kind: EventStarted, Restarts: restarts})
+	go func() {
+		err := runChild(childCtx, spec.Run)
+		cancel()
+		r.exits <- exitMsg{idx: idx, err: err}
+	}()
+}
+
+// runChild 执行子任务并将 panic 转换为 *errorsx.PanicError
+func runChild(ctx context.Context, fn func(context.Context) error) (err error) {
+	defer errorsx.Recover(&err)
+	return fn(ctx)
+}
+
+// markExited 记录子任务退出，返回其状态
+func (r *runState) markExited(m exitMsg) *childState {
+	s := r.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := s.children[m.idx]
+	c.running = false
+	c.lastErr = m.err
+	r.inflight--
+	return c
+}
+
+// handleExit 根据策略处理子任务退出
+func (r *runState) handleExit(m exitMsg) error {
+	c := r.markExited(m)
+	r.emit(Event{Child: c.spec.Name, Kind: EventExited, Err: m.err, Restarts: c.restarts})
+
+	if r.ctx.Err() != nil {
+		return nil
+	}
+	if !needsRestart(c.spec.Restart, m.err) {
+		r.s.mu.Lock()
+		c.finished = true
+		r.s.mu.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+	cutoff := now.Add(-r.s.cfg.period)
+	kept := r.history[:0]
+	for _, t := range r.history {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	r.history = append(kept, now)
+	if len(r.history) > r.s.cfg.maxRestarts {
+		r.emit(Event{Child: c.spec.Name, Kind: EventGaveUp, Err: m.err, Restarts: c.restarts})
+		if m.err != nil {
+			return fmt.Errorf("%w: supervisor %q, child %q: %w", ErrTooManyRestarts, r.s.name, c.spec.Name, m.err)
+		}
+		return fmt.Errorf("%w: supervisor %q, child %q", ErrTooManyRestarts, r.s.name, c.spec.Name)
+	}
+
+	r.s.mu.Lock()
+	// 子任务稳定运行超过一个统计周期后重置退避
+	if now.Sub(c.started) > r.s.cfg.period {
+		c.failures = 0
+	}
+	backoff := r.backoff(c.failures)
+	c.failures++
+	c.restarts++
+	restarts := c.restarts
+	r.s.mu.Unlock()
+
+	idx := m.idx
+	if r.s.cfg.strategy == OneForAll {
+		r.stopAll()
+		idx = -1
+	}
+	r.emit(Event{Child: c.spec.Name, Kind: EventRestarting, Err: m.err, Restarts: restarts, Backoff: backoff})
+	go func() {
+		timer := time.NewTimer(backoff)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			select {
+			case r.restart <- idx:
+			case <-r.ctx.Done():
+			}
+		case <-r.ctx.Done():
+		}
+	}()
+	return nil
+}
+
+// stopAll 取消所有运行中的子任务并等待其退出
+func (r *runState) stopAll() {
+	r.s.mu.Lock()
+	for _, c := range r.s.children {
+		if c.running && c.cancel != nil {
+			c.cancel()
+		}
+	}
+	r.s.mu.Unlock()
+
+	for r.inflight > 0 {
+		m := <-r.exits
+		c := r.markExited(m)
+		r.emit(Event{Child: c.spec.Name, Kind: EventExited, Err: m.err, Restarts: c.restarts})
+	}
+}
+
+// backoff 计算第 n 次连续失败后的等待时间
+func (r *runState) backoff(n int) time.Duration {
+	d := r.s.cfg.minBackoff
+	for i := 0; i < n && d < r.s.cfg.maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, r.s.cfg.maxBackoff)
+}
+
+func needsRestart(p RestartPolicy, err error) bool {
+	switch p {
+	case Permanent:
+		return true
+	case Transient:
+		return err != nil
+	default:
+		return false
+	}
+}
@@ -0,0 +1,355 @@
+package taskgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Values 按名称存放任务的输入与输出
+type Values map[string]any
+
+// Task 一个声明了命名输入和输出的任务
+type Task struct {
+	Name    string
+	Inputs  []string
+	Outputs []string
+	// Run 接收声明的输入，返回声明的输出
+	Run func(ctx context.Context, in Values) (Values, error)
+}
+
+// CycleError 任务依赖中存在环
+type CycleError struct {
+	Path []string
+}
+
+func (e *CycleError) Error() string {
+	return "taskgraph: dependency cycle: " + strings.Join(e.Path, " -> ")
+}
+
+var (
+	// ErrMissingInput 外部输入未提供
+	ErrMissingInput = errors.New("taskgraph: missing input")
+	// ErrMissingOutput 任务没有返回声明的输出
+	ErrMissingOutput = errors.New("taskgraph: missing output")
+)
+
+// ==================== 构建 ====================
+
+// Builder 收集任务并在 Build 时校验依赖关系
+type Builder struct {
+	tasks []Task
+}
+
+// NewBuilder 创建 Builder
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Add 添加任务
+func (b *Builder) Add(tasks ...Task) *Builder {
+	b.tasks = append(b.tasks, tasks...)
+	return b
+}
+
+// task 已解析依赖的任务
+type task struct {
+	Task
+	deps []*task
+}
+
+// Build 计算执行顺序，任务名或输出重复、存在环时返回错误
+func (b *Builder) Build() (*Graph, error) {
+	g := &Graph{
+		tasks:     make(map[string]*task, len(b.tasks)),
+		producers: make(map[string]*task),
+	}
+
+	all := make([]*task, 0, len(b.tasks))
+	for _, t := range b.tasks {
+		if t.Run == nil {
+			return nil, fmt.Errorf("taskgraph: task %q has no Run function", t.Name)
+		}
+		if _, ok := g.tasks[t.Name]; ok {
+			return nil, fmt.Errorf("taskgraph: duplicate task %q", t.Name)
+		}
+		tt := &task{Task: t}
+		g.tasks[t.Name] = tt
+		all = append(all, tt)
+		for _, out := range t.Outputs {
+			if p, ok := g.producers[out]; ok {
+				return nil, fmt.Errorf("taskgraph: output %q produced by both %q and %q", out, p.Name, t.Name)
+			}
+			g.producers[out] = tt
+		}
+	}
+
+	external := make(map[string]struct{})
+	for _, t := range all {
+		seen := make(map[*task]struct{})
+		for _, in := range t.Inputs {
+			p, ok := g.producers[in]
+			if !ok {
+				external[in] = struct{}{}
+				continue
+			}
+			if _, dup := seen[p]; !dup {
+				seen[p] = struct{}{}
+				t.deps = append(t.deps, p)
+			}
+		}
+	}
+	for in := range external {
+		g.external = append(g.external, in)
+	}
+	sort.Strings(g.external)
+
+	order, err := topoSort(all)
+	if err != nil {
+		return nil, err
+	}
+	g.order = order
+	return g, nil
+}
+
+// topoSort 按依赖排序（Kahn 算法），失败时给出环的路径
+func topoSort(all []*task) ([]*task, error) {
+	indegree := make(map[*task]int, len(all))
+	dependents := make(map[*task][]*task, len(all))
+	for _, t := range all {
+		indegree[t] += 0
+		for _, d := range t.deps {
+			indegree[t]++
+			dependents[d] = append(dependents[d], t)
+		}
+	}
+
+	var queue, order []*task
+	for _, t := range all {
+		if indegree[t] == 0 {
+			queue = append(queue, t)
+		}
+	}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		order = append(order, t)
+		for _, d := range dependents[t] {
+			indegree[d]--
+			if indegree[d] == 0 {
+				queue = append(queue, d)
+			}
+		}
+	}
+	if len(order) == len(all) {
+		return order, nil
+	}
+
+	for _, t := range all {
+		if indegree[t] > 0 {
+			return nil, &CycleError{Path: findCycle(t)}
+		}
+	}
+	return nil, &CycleError{}
+}
+
+// findCycle 从 start 出发沿依赖查找一个环
+func findCycle(start *task) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[*task]int)
+	var stack []*task
+	var cycle []string
+
+	var visit func(t *task) bool
+	visit = func(t *task) bool {
+		state[t] = visiting
+		stack = append(stack, t)
+		for _, d := range t.deps {
+			switch state[d] {
+			case visiting:
+				for i := len(stack) - 1; i >= 0; i-- {
+					cycle = append([]string{stack[i].Name}, cycle...)
+					if stack[i] == d {
+						break
+					}
+				}
+				cycle = append(cycle, d.Name)
+				return true
+			case unvisited:
+				if visit(d) {
+					return true
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[t] = visited
+		return false
+	}
+	visit(start)
+	return cycle
+}
+
+// ==================== 执行 ====================
+
+// Graph 已校验的任务图，记住上一次运行的结果以支持增量执行
+type Graph struct {
+	tasks     map[string]*task
+	producers map[string]*task
+	external  []string
+	order     []*task
+
+	mu     sync.Mutex
+	values Values
+	ran    map[*task]bool
+}
+
+// Order 返回任务的执行顺序
+func (g *Graph) Order() []string {
+	names := make([]string, len(g.order))
+	for i, t := range g.order {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// ExternalInputs 返回需要由调用方提供的输入名称
+func (g *Graph) ExternalInputs() []string {
+	return append([]string(nil), g.external...)
+}
+
+// Report 一次运行的结果
+type Report struct {
+	// Outputs 所有任务输出与外部输入
+	Outputs Values
+	// Executed 本次实际执行的任务
+	Executed []string
+	// Skipped 输入未变化而复用上次结果的任务
+	Skipped []string
+}
+
+// Run 执行任务图
+// 首次运行执行所有任务；之后只重新执行输入（直接或间接）发生变化的任务，
+// 若任务重新执行后输出未变，下游任务也不会被重新执行
+func (g *Graph) Run(ctx context.Context, inputs Values) (*Report, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, name := range g.external {
+		if _, ok := inputs[name]; !ok {
+			return nil, fmt.Errorf("%w: %q", ErrMissingInput, name)
+		}
+	}
+
+	if g.values == nil {
+		g.values = make(Values)
+		g.ran = make(map[*task]bool)
+	}
+
+	changed := make(map[string]bool)
+	for _, name := range g.external {
+		old, ok := g.values[name]
+		if !ok || !reflect.DeepEqual(old, inputs[name]) {
+			changed[name] = true
+			g.values[name] = inputs[name]
+		}
+	}
+
+	report := &Report{}
+	for i, t := range g.order {
+		if err := ctx.Err(); err != nil {
+			g.markStale(g.order[i:], changed)
+			return report, err
+		}
+
+		dirty := !g.ran[t]
+		for _, in := range t.Inputs {
+			if changed[in] {
+				dirty = true
+				break
+			}
+		}
+		if !dirty {
+			report.Skipped = append(report.Skipped, t.Name)
+			continue
+		}
+
+		in := make(Values, len(t.Inputs))
+		for _, name := range t.Inputs {
+			in[name] = g.values[name]
+		}
+		out, err := t.Run(ctx, in)
+		if err != nil {
+			g.markStale(g.order[i:], changed)
+			return report, fmt.Errorf("taskgraph: task %q: %w", t.Name, err)
+		}
+		for _, name := range t.Outputs {
+			v, ok := out[name]
+			if !ok {
+				g.markStale(g.order[i:], changed)
+				return report, fmt.Errorf("%w: task %q did not produce %q", ErrMissingOutput, t.Name, name)
+			}
+			old, had := g.values[name]
+			if !had || !reflect.DeepEqual(old, v) {
+				changed[name] = true
+				g.values[name] = v
+			}
+		}
+		g.ran[t] = true
+		report.Executed = append(report.Executed, t.Name)
+	}
+
+	report.Outputs = make(Values, len(g.values))
+	for k, v := range g.values {
+		report.Outputs[k] = v
+	}
+	return report, nil
+}
+
+// markStale 运行中断时标记未完成的任务（失败任务及输入已变化的下游）需要重新执行
+func (g *Graph) markStale(rest []*task, changed map[string]bool) {
+	for j, t := range rest {
+		stale := j == 0
+		for _, in := range t.Inputs {
+			if changed[in] {
+				stale = true
+				break
+			}
+		}
+		if stale {
+			g.ran[t] = false
+			for _, out := range t.Outputs {
+				changed[out] = true
+			}
+		}
+	}
+}
+
+// Invalidate 强制指定任务及其下游在下次运行时重新执行
+func (g *Graph) Invalidate(names ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, name := range names {
+		if t, ok := g.tasks[name]; ok {
+			delete(g.ran, t)
+			for _, out := range t.Outputs {
+				delete(g.values, out)
+			}
+		}
+	}
+}
+
+// Reset 清除所有缓存结果
+func (g *Graph) Reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values = nil
+	g.ran = nil
+}
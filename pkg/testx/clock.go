@@ -0,0 +1,188 @@
+package testx
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/timex"
+)
+
+// FakeClock 手动推进的假时钟，用于编写不依赖真实睡眠的确定性测试
+// 可以作为 sched.WithTimers 的定时器来源，也可以驱动手动时间轮，
+// 从而让 timex.GetWithTimeout 等超时逻辑由 Advance 触发。
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []*fakeTimer
+	wheels  []*drivenWheel
+	waiters []waiter
+}
+
+type fakeTimer struct {
+	at time.Time
+	c  chan time.Time
+	f  func()
+}
+
+type drivenWheel struct {
+	w    *timex.Wheel
+	next time.Time
+}
+
+type waiter struct {
+	n  int
+	ch chan struct{}
+}
+
+// NewFakeClock 创建起始于 start 的假时钟
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now 返回假时钟的当前时间
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Since 返回自 t 以来经过的假时间
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// After 返回在假时间推进 d 后收到时间的通道
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	ch, _ := c.NewTimer(d)
+	return ch
+}
+
+// NewTimer 创建定时器，返回到期通道和停止函数
+func (c *FakeClock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	t := &fakeTimer{c: make(chan time.Time, 1)}
+	c.add(t, d)
+	return t.c, func() bool { return c.remove(t) }
+}
+
+// AfterFunc 在假时间推进 d 后于新的 goroutine 中调用 f
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) func() bool {
+	t := &fakeTimer{f: f}
+	c.add(t, d)
+	return func() bool { return c.remove(t) }
+}
+
+// TimerFunc 返回可用于 sched.WithTimers 的定时器工厂
+func (c *FakeClock) TimerFunc() func(d time.Duration) (<-chan time.Time, func() bool) {
+	return c.NewTimer
+}
+
+// DriveWheel 让 Advance 按 w 的精度推进手动时间轮（timex.NewManualWheel）
+func (c *FakeClock) DriveWheel(w *timex.Wheel) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.wheels = append(c.wheels, &drivenWheel{w: w, next: c.now.Add(w.TickDuration())})
+}
+
+// Pending 返回尚未到期的定时器数量
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// BlockUntil 阻塞直到至少有 n 个未到期的定时器
+// 用于在 Advance 之前确认被测代码已经开始等待
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	if len(c.timers) >= n {
+		c.mu.Unlock()
+		return
+	}
+	w := waiter{n: n, ch: make(chan struct{})}
+	c.waiters = append(c.waiters, w)
+	c.mu.Unlock()
+	<-w.ch
+}
+
+// Advance 将时间推进 d，按到期顺序触发定时器并推进时间轮
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	c.mu.Unlock()
+
+	for {
+		c.mu.Lock()
+		var (
+			fire  *fakeTimer
+			wheel *drivenWheel
+			at    = target
+		)
+		if len(c.timers) > 0 && !c.timers[0].at.After(at) {
+			fire, at = c.timers[0], c.timers[0].at
+		}
+		for _, dw := range c.wheels {
+			if !dw.next.After(at) {
+				fire, wheel, at = nil, dw, dw.next
+			}
+		}
+		c.now = at
+		if fire == nil && wheel == nil {
+			c.mu.Unlock()
+			return
+		}
+		if fire != nil {
+			c.timers = c.timers[1:]
+		} else {
+			wheel.next = wheel.next.Add(wheel.w.TickDuration())
+		}
+		c.mu.Unlock()
+
+		switch {
+		case wheel != nil:
+			wheel.w.Tick(at)
+		case fire.f != nil:
+			go fire.f()
+		default:
+			fire.c <- at
+		}
+	}
+}
+
+func (c *FakeClock) add(t *fakeTimer, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t.at = c.now.Add(d)
+	i := sort.Search(len(c.timers), func(i int) bool { return c.timers[i].at.After(t.at) })
+	c.timers = append(c.timers, nil)
+	copy(c.timers[i+1:], c.timers[i:])
+	c.timers[i] = t
+
+	kept := c.waiters[:0]
+	for _, w := range c.waiters {
+		if len(c.timers) >= w.n {
+			close(w.ch)
+		} else {
+			kept = append(kept, w)
+		}
+	}
+	c.waiters = kept
+}
+
+func (c *FakeClock) remove(t *fakeTimer) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, x := range c.timers {
+		if x == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			if t.c != nil {
+				select {
+				case <-t.c:
+				default:
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
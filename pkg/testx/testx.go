@@ -0,0 +1,115 @@
+package testx
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"testing"
+	"time"
+)
+
+// ==================== 轮询断言 ====================
+
+// Eventually 每隔 tick 检查一次 cond，timeout 内未变为真则测试失败
+func Eventually(t testing.TB, cond func() bool, timeout, tick time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	if cond() {
+		return
+	}
+	for {
+		select {
+		case <-deadline.C:
+			t.Fatalf("condition not met within %v%s", timeout, message(msgAndArgs))
+			return
+		case <-ticker.C:
+			if cond() {
+				return
+			}
+		}
+	}
+}
+
+// Never 每隔 tick 检查一次 cond，在 duration 内变为真则测试失败
+func Never(t testing.TB, cond func() bool, duration, tick time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	deadline := time.NewTimer(duration)
+	defer deadline.Stop()
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	if cond() {
+		t.Fatalf("condition became true%s", message(msgAndArgs))
+		return
+	}
+	for {
+		select {
+		case <-deadline.C:
+			return
+		case <-ticker.C:
+			if cond() {
+				t.Fatalf("condition became true%s", message(msgAndArgs))
+				return
+			}
+		}
+	}
+}
+
+func message(msgAndArgs []any) string {
+	if len(msgAndArgs) == 0 {
+		return ""
+	}
+	if format, ok := msgAndArgs[0].(string); ok {
+		return ": " + fmt.Sprintf(format, msgAndArgs[1:]...)
+	}
+	return ": " + fmt.Sprint(msgAndArgs...)
+}
+
+// ==================== 并发执行 ====================
+
+// ConcurrentRun 并发运行 n 个 fn(i)，等待全部结束
+// fn 返回的错误和 panic（附带堆栈）都会在测试的 goroutine 中报告为失败，
+// 因此 fn 内不需要也不应调用 t.FailNow。
+func ConcurrentRun(t testing.TB, n int, fn func(i int) error) {
+	t.Helper()
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		fails []string
+		start = make(chan struct{})
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					fails = append(fails, fmt.Sprintf("goroutine %d panicked: %v\n%s", i, r, debug.Stack()))
+					mu.Unlock()
+				}
+			}()
+			// 所有 goroutine 同时开始，尽量放大竞争
+			<-start
+			if err := fn(i); err != nil {
+				mu.Lock()
+				fails = append(fails, fmt.Sprintf("goroutine %d: %v", i, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	for _, f := range fails {
+		t.Error(f)
+	}
+	if len(fails) > 0 {
+		t.FailNow()
+	}
+}
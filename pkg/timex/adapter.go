@@ -0,0 +1,43 @@
+package timex
+
+import (
+	"time"
+
+	"github.com/hunter-hongg/GoPlus/pkg/future"
+)
+
+// TimerFunc 返回基于时间轮的定时器工厂，可用于 sched.WithTimers
+func (w *Wheel) TimerFunc() func(d time.Duration) (<-chan time.Time, func() bool) {
+	return func(d time.Duration) (<-chan time.Time, func() bool) {
+		t := w.NewTimer(d)
+		return t.C, t.Stop
+	}
+}
+
+// GetWithTimeout 与 future.Future.GetWithTimeout 相同，但超时由时间轮驱动
+// 超时或 Future 被取消时返回 false
+func GetWithTimeout[T any](w *Wheel, f future.Future[T], timeout time.Duration) (T, bool) {
+	var zero T
+	select {
+	case <-f.Done():
+		return f.Get(), true
+	default:
+	}
+
+	t := w.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case <-f.Done():
+		return f.Get(), true
+	case <-t.C:
+		return zero, false
+	case <-future.Cancelled(f):
+		return zero, false
+	}
+}
+
+// Wait 与 future.Future.Wait(timeout) 相同，但超时由时间轮驱动
+func Wait[T any](w *Wheel, f future.Future[T], timeout time.Duration) bool {
+	_, ok := GetWithTimeout(w, f, timeout)
+	return ok
+}
@@ -0,0 +1,193 @@
+package timex
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Wheel 哈希时间轮，适合同时管理大量超时
+// 定时器的精度为一个 tick：到期时间不早于 d，且落在 [d, d+2·tick) 内。
+// 添加、停止和重置都是 O(1)，且不会为每个定时器创建运行时 timer。
+type Wheel struct {
+	tick  time.Duration
+	slots []*list.List
+
+	mu      sync.Mutex
+	pos     int
+	stopped bool
+	done    chan struct{}
+}
+
+// Timer 时间轮上的定时器
+type Timer struct {
+	// C 到期时收到当前时间，仅由 NewTimer 创建的定时器有效
+	C <-chan time.Time
+
+	c      chan time.Time
+	f      func()
+	w      *Wheel
+	elem   *list.Element
+	slot   int
+	rounds int
+}
+
+// NewWheel 创建时间轮并开始转动，tick 为精度，slots 为槽位数
+func NewWheel(tick time.Duration, slots int) *Wheel {
+	w := NewManualWheel(tick, slots)
+	go w.run()
+	return w
+}
+
+// NewManualWheel 创建不会自行转动的时间轮，需要调用 Tick 推进
+// 用于测试中配合假时钟实现确定性的超时
+func NewManualWheel(tick time.Duration, slots int) *Wheel {
+	if tick <= 0 || slots <= 0 {
+		panic("timex: non-positive tick or slots for wheel")
+	}
+	w := &Wheel{
+		tick:  tick,
+		slots: make([]*list.List, slots),
+		done:  make(chan struct{}),
+	}
+	for i := range w.slots {
+		w.slots[i] = list.New()
+	}
+	return w
+}
+
+// TickDuration 返回时间轮的精度
+func (w *Wheel) TickDuration() time.Duration { return w.tick }
+
+// Tick 手动推进一个 tick 并同步触发到期的定时器，now 作为发送给 C 的时间
+func (w *Wheel) Tick(now time.Time) {
+	w.advance(now)
+}
+
+// Stop 停止时间轮，尚未到期的定时器不会再触发
+func (w *Wheel) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.stopped {
+		w.stopped = true
+		close(w.done)
+	}
+}
+
+func (w *Wheel) run() {
+	ticker := time.NewTicker(w.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case now := <-ticker.C:
+			w.advance(now)
+		}
+	}
+}
+
+// advance 前进一个槽位并触发到期的定时器
+func (w *Wheel) advance(now time.Time) {
+	w.mu.Lock()
+	w.pos = (w.pos + 1) % len(w.slots)
+	slot := w.slots[w.pos]
+	var fired []*Timer
+	for e := slot.Front(); e != nil; {
+		next := e.Next()
+		t := e.Value.(*Timer)
+		if t.rounds > 0 {
+			t.rounds--
+		} else {
+			slot.Remove(e)
+			t.elem = nil
+			fired = append(fired, t)
+		}
+		e = next
+	}
+	w.mu.Unlock()
+
+	for _, t := range fired {
+		if t.f != nil {
+			go t.f()
+			continue
+		}
+		select {
+		case t.c <- now:
+		default:
+		}
+	}
+}
+
+// addLocked 将定时器放入 d 之后到期的槽位，调用方需持有 w.mu
+func (w *Wheel) addLocked(t *Timer, d time.Duration) {
+	if w.stopped {
+		return
+	}
+	// 槽位按 tick 对齐推进，距下一次推进可能不足一个 tick，
+	// 因此多等一个 tick 以保证不会提前触发
+	ticks := int((d+w.tick-1)/w.tick) + 1
+	if ticks < 1 {
+		ticks = 1
+	}
+	n := len(w.slots)
+	t.slot = (w.pos + ticks) % n
+	t.rounds = (ticks - 1) / n
+	t.elem = w.slots[t.slot].PushBack(t)
+}
+
+// AfterFunc 在 d 之后于新的 goroutine 中调用 f
+func (w *Wheel) AfterFunc(d time.Duration, f func()) *Timer {
+	t := &Timer{w: w, f: f}
+	w.mu.Lock()
+	w.addLocked(t, d)
+	w.mu.Unlock()
+	return t
+}
+
+// NewTimer 创建在 d 之后向 C 发送当前时间的定时器
+func (w *Wheel) NewTimer(d time.Duration) *Timer {
+	c := make(chan time.Time, 1)
+	t := &Timer{w: w, c: c, C: c}
+	w.mu.Lock()
+	w.addLocked(t, d)
+	w.mu.Unlock()
+	return t
+}
+
+// After 等价于 NewTimer(d).C
+func (w *Wheel) After(d time.Duration) <-chan time.Time {
+	return w.NewTimer(d).C
+}
+
+// Stop 停止定时器，返回定时器是否在到期前被停止
+// 对于 NewTimer 创建的定时器，会丢弃 C 中尚未读取的值
+func (t *Timer) Stop() bool {
+	t.w.mu.Lock()
+	defer t.w.mu.Unlock()
+	return t.stopLocked()
+}
+
+func (t *Timer) stopLocked() bool {
+	active := t.elem != nil
+	if active {
+		t.w.slots[t.slot].Remove(t.elem)
+		t.elem = nil
+	}
+	if t.c != nil {
+		select {
+		case <-t.c:
+		default:
+		}
+	}
+	return active
+}
+
+// Reset 重新设置为 d 之后到期，返回定时器在重置前是否仍处于活动状态
+func (t *Timer) Reset(d time.Duration) bool {
+	t.w.mu.Lock()
+	defer t.w.mu.Unlock()
+	active := t.stopLocked()
+	t.w.addLocked(t, d)
+	return active
+}
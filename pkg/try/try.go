@@ -0,0 +1,88 @@
+// Package try 通过 panic 短路传播错误，省去逐层的 if err != nil 判断。
+//
+//	func load(path string) (cfg Config, err error) {
+//		defer try.Handle(&err)
+//		data := try.To1(os.ReadFile(path))
+//		try.To(json.Unmarshal(data, &cfg))
+//		return cfg, nil
+//	}
+//
+// To 系列函数遇到错误时抛出内部包装类型，只有 Handle 系列函数会将其恢复为错误，
+// 其他 panic 原样继续传播。try 不应跨越函数边界使用：每个调用 To 的函数都应 defer Handle。
+package try
+
+import "github.com/hunter-hongg/GoPlus/pkg/option"
+
+// failure 错误的 panic 包装，用于与普通 panic 区分
+type failure struct {
+	err error
+}
+
+// To 在 err 不为 nil 时中止当前函数
+func To(err error) {
+	if err != nil {
+		panic(failure{err: err})
+	}
+}
+
+// To1 在 err 不为 nil 时中止当前函数，否则返回 v
+func To1[T any](v T, err error) T {
+	To(err)
+	return v
+}
+
+// To2 在 err 不为 nil 时中止当前函数，否则返回 v1, v2
+func To2[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) {
+	To(err)
+	return v1, v2
+}
+
+// To3 在 err 不为 nil 时中止当前函数，否则返回 v1, v2, v3
+func To3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
+	To(err)
+	return v1, v2, v3
+}
+
+// Result 解包 Result，Err 时中止当前函数
+func Result[T any](r option.Result[T, error]) T {
+	if r.IsErr() {
+		To(r.UnwrapErr())
+	}
+	return r.Unwrap()
+}
+
+// Handle 恢复 To 系列函数抛出的错误并写入 *errp，必须直接由 defer 调用
+func Handle(errp *error) {
+	if r := recover(); r != nil {
+		*errp = recovered(r)
+	}
+}
+
+// HandleWith 与 Handle 相同，但先用 fn 处理错误（例如添加上下文）
+func HandleWith(errp *error, fn func(error) error) {
+	if r := recover(); r != nil {
+		*errp = fn(recovered(r))
+	}
+}
+
+// HandleResult 恢复错误并写入 *res 作为 Err，必须直接由 defer 调用
+func HandleResult[T any](res *option.Result[T, error]) {
+	if r := recover(); r != nil {
+		*res = option.Err[T](recovered(r))
+	}
+}
+
+// Catch 执行 fn，将 To 系列函数抛出的错误作为返回值
+func Catch(fn func()) (err error) {
+	defer Handle(&err)
+	fn()
+	return nil
+}
+
+// recovered 从 panic 值中取出错误，不是 To 抛出的 panic 继续传播
+func recovered(r any) error {
+	if f, ok := r.(failure); ok {
+		return f.err
+	}
+	panic(r)
+}
@@ -0,0 +1,35 @@
+package tuple
+
+import "github.com/hunter-hongg/GoPlus/pkg/future"
+
+// FromFuture2 将双返回值 Future 转换为 Pair 的 Future
+func FromFuture2[A, B any](f future.Future2[A, B]) future.Future[Pair[A, B]] {
+	return future.NewE(func() (Pair[A, B], error) {
+		a, b := f.Get()
+		return NewPair(a, b), f.Error()
+	})
+}
+
+// FromFuture3 将三返回值 Future 转换为 Triple 的 Future
+func FromFuture3[A, B, C any](f future.Future3[A, B, C]) future.Future[Triple[A, B, C]] {
+	return future.NewE(func() (Triple[A, B, C], error) {
+		a, b, c := f.Get()
+		return NewTriple(a, b, c), f.Error()
+	})
+}
+
+// ToFuture2 将 Pair 的 Future 转换为双返回值 Future
+func ToFuture2[A, B any](f future.Future[Pair[A, B]]) future.Future2[A, B] {
+	return future.New2E(func() (A, B, error) {
+		p := f.Get()
+		return p.First, p.Second, f.Error()
+	})
+}
+
+// ToFuture3 将 Triple 的 Future 转换为三返回值 Future
+func ToFuture3[A, B, C any](f future.Future[Triple[A, B, C]]) future.Future3[A, B, C] {
+	return future.New3E(func() (A, B, C, error) {
+		t := f.Get()
+		return t.First, t.Second, t.Third, f.Error()
+	})
+}
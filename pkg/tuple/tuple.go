@@ -0,0 +1,143 @@
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ==================== Pair ====================
+
+// Pair 二元组
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair 创建二元组
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+// Unpack 拆解为两个值
+func (p Pair[A, B]) Unpack() (A, B) { return p.First, p.Second }
+
+// Swap 交换两个元素
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// MarshalJSON 编码为 JSON 数组 [first, second]
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{p.First, p.Second})
+}
+
+// UnmarshalJSON 从长度为 2 的 JSON 数组解码
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &p.First, &p.Second)
+}
+
+// MapFirst 转换第一个元素
+func MapFirst[A, B, C any](p Pair[A, B], f func(A) C) Pair[C, B] {
+	return Pair[C, B]{First: f(p.First), Second: p.Second}
+}
+
+// MapSecond 转换第二个元素
+func MapSecond[A, B, C any](p Pair[A, B], f func(B) C) Pair[A, C] {
+	return Pair[A, C]{First: p.First, Second: f(p.Second)}
+}
+
+// MapPair 同时转换两个元素
+func MapPair[A, B, C, D any](p Pair[A, B], fa func(A) C, fb func(B) D) Pair[C, D] {
+	return Pair[C, D]{First: fa(p.First), Second: fb(p.Second)}
+}
+
+// ==================== Triple ====================
+
+// Triple 三元组
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple 创建三元组
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// Unpack 拆解为三个值
+func (t Triple[A, B, C]) Unpack() (A, B, C) { return t.First, t.Second, t.Third }
+
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
+
+// MarshalJSON 编码为 JSON 数组
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+// UnmarshalJSON 从长度为 3 的 JSON 数组解码
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.First, &t.Second, &t.Third)
+}
+
+// MapTriple 同时转换三个元素
+func MapTriple[A, B, C, D, E, F any](t Triple[A, B, C], fa func(A) D, fb func(B) E, fc func(C) F) Triple[D, E, F] {
+	return Triple[D, E, F]{First: fa(t.First), Second: fb(t.Second), Third: fc(t.Third)}
+}
+
+// ==================== Quad ====================
+
+// Quad 四元组
+type Quad[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// NewQuad 创建四元组
+func NewQuad[A, B, C, D any](a A, b B, c C, d D) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{First: a, Second: b, Third: c, Fourth: d}
+}
+
+// Unpack 拆解为四个值
+func (q Quad[A, B, C, D]) Unpack() (A, B, C, D) { return q.First, q.Second, q.Third, q.Fourth }
+
+func (q Quad[A, B, C, D]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", q.First, q.Second, q.Third, q.Fourth)
+}
+
+// MarshalJSON 编码为 JSON 数组
+func (q Quad[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{q.First, q.Second, q.Third, q.Fourth})
+}
+
+// UnmarshalJSON 从长度为 4 的 JSON 数组解码
+func (q *Quad[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &q.First, &q.Second, &q.Third, &q.Fourth)
+}
+
+// ==================== 工具函数 ====================
+
+// unmarshalArray 将 JSON 数组逐个解码到 dst
+func unmarshalArray(data []byte, dst ...any) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != len(dst) {
+		return fmt.Errorf("tuple: expected JSON array of length %d, got %d", len(dst), len(raw))
+	}
+	for i, r := range raw {
+		if err := json.Unmarshal(r, dst[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
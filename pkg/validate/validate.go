@@ -0,0 +1,187 @@
+package validate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/hunter-hongg/GoPlus/pkg/option"
+)
+
+// ErrInvalid 所有校验失败错误都包装了该错误
+var ErrInvalid = errors.New("validate: invalid value")
+
+// FieldError 字段级校验错误
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error { return e.Err }
+
+// ==================== 规则 ====================
+
+// Rule 校验规则，返回 nil 表示通过
+type Rule[T any] func(T) error
+
+// invalid 构造包装了 ErrInvalid 的错误
+func invalid(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalid, fmt.Sprintf(format, args...))
+}
+
+// Custom 由谓词构造规则，不满足时返回 msg
+func Custom[T any](pred func(T) bool, msg string) Rule[T] {
+	return func(v T) error {
+		if !pred(v) {
+			return invalid("%s", msg)
+		}
+		return nil
+	}
+}
+
+// NonEmpty 字符串不能为空
+func NonEmpty[T ~string]() Rule[T] {
+	return func(v T) error {
+		if v == "" {
+			return invalid("must not be empty")
+		}
+		return nil
+	}
+}
+
+// NonEmptySlice 切片不能为空
+func NonEmptySlice[T any]() Rule[[]T] {
+	return func(v []T) error {
+		if len(v) == 0 {
+			return invalid("must not be empty")
+		}
+		return nil
+	}
+}
+
+// Length 字符串的字符数必须在 [min, max] 之间
+func Length[T ~string](min, max int) Rule[T] {
+	return func(v T) error {
+		if n := utf8.RuneCountInString(string(v)); n < min || n > max {
+			return invalid("length %d not in [%d, %d]", n, min, max)
+		}
+		return nil
+	}
+}
+
+// Range 值必须在 [min, max] 之间
+func Range[T cmp.Ordered](min, max T) Rule[T] {
+	return func(v T) error {
+		if v < min || v > max {
+			return invalid("%v not in [%v, %v]", v, min, max)
+		}
+		return nil
+	}
+}
+
+// OneOf 值必须是给定值之一
+func OneOf[T comparable](allowed ...T) Rule[T] {
+	return func(v T) error {
+		for _, a := range allowed {
+			if v == a {
+				return nil
+			}
+		}
+		return invalid("%v not in %v", v, allowed)
+	}
+}
+
+// MatchRegex 字符串必须匹配正则表达式，pattern 无效时 panic
+func MatchRegex[T ~string](pattern string) Rule[T] {
+	re := regexp.MustCompile(pattern)
+	return func(v T) error {
+		if !re.MatchString(string(v)) {
+			return invalid("%q does not match %s", string(v), pattern)
+		}
+		return nil
+	}
+}
+
+// All 依次应用所有规则，合并全部错误
+func All[T any](rules ...Rule[T]) Rule[T] {
+	return func(v T) error {
+		var errs []error
+		for _, r := range rules {
+			if err := r(v); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// Optional 仅在 Option 有值时应用规则
+func Optional[T any](rules ...Rule[T]) Rule[option.Option[T]] {
+	all := All(rules...)
+	return func(o option.Option[T]) error {
+		if o.IsNone() {
+			return nil
+		}
+		return all(o.Unwrap())
+	}
+}
+
+// ==================== 结构体校验 ====================
+
+// Validator 聚合多个字段规则的结构体校验器
+type Validator[T any] struct {
+	checks []func(T) error
+}
+
+// New 创建校验器
+func New[T any]() *Validator[T] {
+	return &Validator[T]{}
+}
+
+// Field 为 get 取出的字段添加规则，错误以 FieldError 报告
+func Field[T, F any](v *Validator[T], name string, get func(T) F, rules ...Rule[F]) *Validator[T] {
+	all := All(rules...)
+	v.checks = append(v.checks, func(x T) error {
+		if err := all(get(x)); err != nil {
+			return &FieldError{Field: name, Err: err}
+		}
+		return nil
+	})
+	return v
+}
+
+// Check 添加结构体级规则，例如跨字段约束
+func (v *Validator[T]) Check(rule Rule[T]) *Validator[T] {
+	v.checks = append(v.checks, rule)
+	return v
+}
+
+// Err 校验 x，返回所有错误的合并结果
+func (v *Validator[T]) Err(x T) error {
+	var errs []error
+	for _, c := range v.checks {
+		if err := c(x); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate 校验 x，通过时返回 Ok(x)，否则返回合并了所有错误的 Err
+func (v *Validator[T]) Validate(x T) option.Result[T, error] {
+	if err := v.Err(x); err != nil {
+		return option.Err[T](err)
+	}
+	return option.Ok[T, error](x)
+}
+
+// Rule 将校验器作为规则，用于校验嵌套结构体
+func (v *Validator[T]) Rule() Rule[T] {
+	return v.Err
+}